@@ -0,0 +1,16 @@
+module github.com/pkg/sftp
+
+go 1.23.0
+
+require (
+	github.com/kr/fs v0.1.0
+	github.com/stretchr/testify v1.10.0
+	golang.org/x/crypto v0.41.0
+	golang.org/x/sys v0.35.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
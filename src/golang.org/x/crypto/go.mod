@@ -0,0 +1,11 @@
+module golang.org/x/crypto
+
+go 1.25.0
+
+require (
+	golang.org/x/net v0.56.0 // tagx:ignore
+	golang.org/x/sys v0.47.0
+	golang.org/x/term v0.45.0
+)
+
+require golang.org/x/text v0.40.0 // indirect
@@ -5,4 +5,4 @@
 // This package contains test data shared between the various subpackages of
 // the golang.org/x/crypto/ssh package. Under no circumstance should
 // this data be used for production code.
-package testdata // import "golang.org/x/crypto/ssh/testdata"
+package testdata
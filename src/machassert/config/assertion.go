@@ -2,49 +2,162 @@ package config
 
 // Assertion kinds
 const (
-	FileExistsAssrt    string = "exists"
-	FileNotExistsAssrt string = "!exists"
-	HashMatchAssrt     string = "md5_match"
-	HashFileAssrt      string = "file_match"
-	RegexMatchAssrt    string = "regex_contents_match"
+	FileExistsAssrt       string = "exists"
+	FileNotExistsAssrt    string = "!exists"
+	HashMatchAssrt        string = "md5_match"
+	HashFileAssrt         string = "file_match"
+	RegexMatchAssrt       string = "regex_contents_match"
+	PackageInstalledAssrt string = "package_installed"
+	ServiceRunningAssrt   string = "service_running"
+	ServiceEnabledAssrt   string = "service_enabled"
+	CommandAssrt          string = "command"
+	FileAttributesAssrt   string = "file_attributes"
+	TemplateMatchAssrt    string = "template_match"
 )
 
 // Action kinds
 const (
-	ActionFail     string = "FAIL"
-	ActionCopyFile string = "COPY"
-	ActionAssert   string = "ASSERT"
+	ActionFail           string = "FAIL"
+	ActionCopyFile       string = "COPY"
+	ActionAssert         string = "ASSERT"
+	ActionInstallPackage string = "INSTALL_PACKAGE"
+	ActionService        string = "SERVICE"
+	ActionRun            string = "RUN"
+	ActionMkdir          string = "MKDIR"
+	ActionDelete         string = "DELETE"
+	ActionSymlink        string = "SYMLINK"
+	ActionChmod          string = "CHMOD"
+	ActionChown          string = "CHOWN"
+	ActionTemplate       string = "TEMPLATE"
 )
 
+// Valid on_failure values
+const (
+	OnFailureStop     string = "stop"     // no further assertions are run on the machine (default)
+	OnFailureContinue string = "continue" // later assertions are still run, but the run still fails
+)
+
+// Valid operations for SERVICE actions
+var ServiceOperations = []string{"start", "stop", "restart", "reload", "enable"}
+
+// Valid hash algorithms for md5_match and file_match assertions
+var HashAlgorithms = []string{"md5", "sha1", "sha256", "sha512", "blake2b"}
+
+// Valid file types for file_attributes assertions
+var FileTypes = []string{"file", "directory", "symlink"}
+
 // AssertionSpec describes the high-level schema for a file containing assertions.
 type AssertionSpec struct {
 	Name       string
+	Include    []string              // paths of files whose variables & assertions are merged into this spec
+	Modules    map[string]*Module    `hcl:"module"`
+	Variables  map[string]*Variable  `hcl:"variable"` // defaults, which can be overridden by the targets file
 	Assertions map[string]*Assertion `hcl:"assert"`
 }
 
+// Module describes the schema for a module, which instantiates the assertions in another file
+// as <module name>.<assertion name>, with the variables of that file set to Args.
+type Module struct {
+	Source string
+	Args   map[string]string `hcl:"args"`
+}
+
 // Assertion describes the schema for a assertion.
 type Assertion struct {
 	Kind  string
 	Order int
+	// DependsOn lists assertions (<spec name>.<assertion name>, or the name of an assertion in the same spec)
+	// which must hold before this assertion is run; it is skipped if any of them do not. If unset (nil), the
+	// assertion instead runs after all assertions before it. Set but empty (depends_on = []), it has no
+	// dependencies and can run first.
+	DependsOn []string `hcl:"depends_on"`
+	// OnFailure controls whether later assertions are run if this assertion fails, see OnFailureStop & OnFailureContinue.
+	OnFailure string `hcl:"on_failure"`
+	// IgnoreErrors reports failures of this assertion without failing the run, as if it had held.
+	IgnoreErrors bool `hcl:"ignore_errors"`
+	// Tags are arbitrary labels, which assertions can be selected (or skipped) by with --tags & --skip-tags.
+	Tags []string
 
 	// FileExistsAssrt & FileNotExistsAssrt & HashMatchAssrt
 	FilePath string `hcl:"file_path"`
 	// HashMatchAssrt
 	Hash string //hex-encoded hash bytes
 
+	// HashMatchAssrt & HashFileAssrt
+	HashAlgorithm string `hcl:"hash_algorithm"` //one of HashAlgorithms, defaults to md5
+
 	// HashFileAssrt
 	BasePath string `hcl:"base_path"`
 
 	// RegexMatchAssrt
 	Regex string `hcl:"regex"`
 
+	// PackageInstalledAssrt
+	Package string `hcl:"package"`
+	Version string `hcl:"version"` //optional constraint, eg ">= 1.2.3"
+
+	// ServiceRunningAssrt & ServiceEnabledAssrt
+	Service string `hcl:"service"` //systemd unit name
+
+	// CommandAssrt
+	Command     string `hcl:"command"`      //run with sh -c
+	Timeout     string `hcl:"timeout"`      //eg "30s", no timeout if empty
+	ExitCodes   []int  `hcl:"exit_codes"`   //exit codes which pass, defaults to [0]
+	Stdout      string `hcl:"stdout"`       //expected stdout, ignoring surrounding whitespace
+	StdoutRegex string `hcl:"stdout_regex"` //regular expression stdout must match
+	Stderr      string `hcl:"stderr"`       //expected stderr, ignoring surrounding whitespace
+	StderrRegex string `hcl:"stderr_regex"` //regular expression stderr must match
+
+	// FileAttributesAssrt
+	Mode       string `hcl:"mode"`        //octal permissions, eg "0644"
+	Owner      string `hcl:"owner"`       //user name or numeric uid
+	Group      string `hcl:"group"`       //group name or numeric gid
+	FileType   string `hcl:"file_type"`   //one of FileTypes
+	LinkTarget string `hcl:"link_target"` //only valid when file_type is symlink
+	MinSize    int64  `hcl:"min_size"`    //bytes
+	MaxSize    int64  `hcl:"max_size"`    //bytes, no limit if zero
+
+	// TemplateMatchAssrt
+	Template string `hcl:"template"` //path to a local text/template file
+
 	Actions []*Action `hcl:"or"`
 }
 
 // Action represents the schema for an action taken on assertion failure.
 type Action struct {
-	Kind            string                `hcl:"action"`
-	SourcePath      string                `hcl:"source_path"`
-	DestinationPath string                `hcl:"destination_path"`
-	Assertions      map[string]*Assertion `hcl:"assert"`
+	Kind string `hcl:"action"`
+
+	// ActionCopyFile & ActionTemplate
+	SourcePath      string `hcl:"source_path"`
+	DestinationPath string `hcl:"destination_path"` //TEMPLATE: defaults to the file_path of the assertion
+	Mode            string `hcl:"mode"`             //octal permissions to set on the destination, eg "0644". Also used by MKDIR & CHMOD.
+
+	// ActionTemplate
+	Template string `hcl:"template"` //defaults to the template of a template_match assertion
+
+	// ActionAssert
+	Assertions map[string]*Assertion `hcl:"assert"`
+
+	// ActionInstallPackage
+	Package string `hcl:"package"` //defaults to the package of a package_installed assertion
+
+	// ActionService
+	Service   string `hcl:"service"`   //defaults to the service of a service_* assertion
+	Operation string `hcl:"operation"` //one of ServiceOperations
+
+	// ActionRun
+	Command   string            `hcl:"command"`    //run with sh -c
+	Dir       string            `hcl:"dir"`        //working directory
+	Env       map[string]string `hcl:"env"`        //additional environment variables
+	Stdin     string            `hcl:"stdin"`      //written to the command's standard input
+	Timeout   string            `hcl:"timeout"`    //eg "30s", no timeout if empty
+	ExitCodes []int             `hcl:"exit_codes"` //exit codes considered successful, defaults to [0]
+
+	// ActionMkdir & ActionDelete & ActionSymlink & ActionChmod & ActionChown
+	Path      string `hcl:"path"`      //defaults to the file_path of the assertion
+	Parents   bool   `hcl:"parents"`   //MKDIR: create missing parent directories
+	Recursive bool   `hcl:"recursive"` //DELETE: delete directories and their contents
+	Target    string `hcl:"target"`    //SYMLINK: defaults to the link_target of a file_attributes assertion
+	Owner     string `hcl:"owner"`     //CHOWN: defaults to the owner of a file_attributes assertion. Also used by COPY.
+	Group     string `hcl:"group"`     //CHOWN: defaults to the group of a file_attributes assertion. Also used by COPY.
 }
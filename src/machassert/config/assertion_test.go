@@ -1,6 +1,7 @@
 package config
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/davecgh/go-spew/spew"
@@ -115,3 +116,354 @@ func TestBadRegexContentMatchAssertionErrors(t *testing.T) {
 		t.Errorf("Got %q, Want 'regex must be specified for regex_contents_match assertions'", err)
 	}
 }
+
+func TestBadCopyModeActionErrors(t *testing.T) {
+	_, err := ParseAssertionsSpecFile("testdata/actions/badCopyMode.hcl")
+	if err == nil {
+		t.Fatal("Expected error")
+	}
+	if err.Error() != "mode must be octal permissions (eg \"0644\"), got: 0999" {
+		t.Errorf("Got %q, Want 'mode must be octal permissions (eg \"0644\"), got: 0999'", err)
+	}
+}
+
+func TestPackageInstalledAssertionParse(t *testing.T) {
+	spec, err := ParseAssertionsSpecFile("testdata/assertions/packages.hcl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := spec.Assertions["nginx installed"]
+	if a == nil || a.Kind != PackageInstalledAssrt || a.Package != "nginx" || a.Version != ">= 1.10" {
+		t.Fatalf("Got %s, wanted {Kind:'package_installed', Package:'nginx', Version:'>= 1.10'}", spew.Sdump(a))
+	}
+	if len(a.Actions) != 1 || a.Actions[0].Kind != ActionInstallPackage {
+		t.Errorf("Got %s, wanted a single INSTALL_PACKAGE action", spew.Sdump(a.Actions))
+	}
+}
+
+func TestBadPackageInstalledAssertionErrors(t *testing.T) {
+	_, err := ParseAssertionsSpecFile("testdata/assertions/badPackageInstalled.hcl")
+	if err == nil {
+		t.Fatal("Expected error")
+	}
+	if err.Error() != "package must be specified for package_installed assertions" {
+		t.Errorf("Got %q, Want 'package must be specified for package_installed assertions'", err)
+	}
+}
+
+func TestServiceAssertionParse(t *testing.T) {
+	spec, err := ParseAssertionsSpecFile("testdata/assertions/services.hcl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := spec.Assertions["nginx enabled"]
+	if a == nil || a.Kind != ServiceEnabledAssrt || a.Service != "nginx.service" {
+		t.Fatalf("Got %s, wanted {Kind:'service_enabled', Service:'nginx.service'}", spew.Sdump(a))
+	}
+	if len(a.Actions) != 1 || a.Actions[0].Kind != ActionService || a.Actions[0].Operation != "enable" {
+		t.Errorf("Got %s, wanted a single SERVICE enable action", spew.Sdump(a.Actions))
+	}
+}
+
+func TestBadServiceActionErrors(t *testing.T) {
+	_, err := ParseAssertionsSpecFile("testdata/actions/badService.hcl")
+	if err == nil {
+		t.Fatal("Expected error")
+	}
+	if err.Error() != "operation must be one of start/stop/restart/reload/enable for SERVICE actions" {
+		t.Errorf("Got %q, Want 'operation must be one of start/stop/restart/reload/enable for SERVICE actions'", err)
+	}
+}
+
+func TestRunActionParse(t *testing.T) {
+	spec, err := ParseAssertionsSpecFile("testdata/actions/run.hcl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	actions := spec.Assertions["app built"].Actions
+	if len(actions) != 2 {
+		t.Fatalf("Got %d actions, wanted 2", len(actions))
+	}
+	a := actions[0]
+	if a.Kind != ActionRun || a.Command != "make install" || a.Dir != "/opt/app/src" || a.Timeout != "5m" || a.Env["PREFIX"] != "/opt/app" {
+		t.Errorf("Incorrect action data, got: %s", spew.Sdump(a))
+	}
+	if len(a.ExitCodes) != 2 || a.ExitCodes[0] != 0 || a.ExitCodes[1] != 2 {
+		t.Errorf("Got exit_codes=%v, wanted [0 2]", a.ExitCodes)
+	}
+	// exit_codes should default to [0]
+	if len(actions[1].ExitCodes) != 1 || actions[1].ExitCodes[0] != 0 {
+		t.Errorf("Got exit_codes=%v, wanted [0]", actions[1].ExitCodes)
+	}
+}
+
+func TestBadRunActionErrors(t *testing.T) {
+	_, err := ParseAssertionsSpecFile("testdata/actions/badRunTimeout.hcl")
+	if err == nil {
+		t.Fatal("Expected error")
+	}
+	if err.Error() != "invalid timeout for RUN action: 5 minutes" {
+		t.Errorf("Got %q, Want 'invalid timeout for RUN action: 5 minutes'", err)
+	}
+}
+
+func TestCommandAssertionParse(t *testing.T) {
+	spec, err := ParseAssertionsSpecFile("testdata/assertions/command.hcl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := spec.Assertions["kernel version"]
+	if a == nil || a.Kind != CommandAssrt || a.Command != "uname -r" || a.StdoutRegex != "^4\\.9\\." {
+		t.Fatalf("Got %s, wanted {Kind:'command', Command:'uname -r', StdoutRegex:'^4\\.9\\.'}", spew.Sdump(a))
+	}
+	// exit_codes should default to [0]
+	if len(a.ExitCodes) != 1 || a.ExitCodes[0] != 0 {
+		t.Errorf("Got exit_codes=%v, wanted [0]", a.ExitCodes)
+	}
+	if a2 := spec.Assertions["java version"]; len(a2.ExitCodes) != 2 || a2.Timeout != "10s" {
+		t.Errorf("Incorrect assertion data, got: %s", spew.Sdump(a2))
+	}
+}
+
+func TestBadCommandAssertionErrors(t *testing.T) {
+	_, err := ParseAssertionsSpecFile("testdata/assertions/badCommand.hcl")
+	if err == nil {
+		t.Fatal("Expected error")
+	}
+	if !strings.HasPrefix(err.Error(), "invalid regex for command assertion: ") {
+		t.Errorf("Got %q, Want 'invalid regex for command assertion: ...'", err)
+	}
+}
+
+func TestFileAttributesAssertionParse(t *testing.T) {
+	spec, err := ParseAssertionsSpecFile("testdata/assertions/fileAttributes.hcl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := spec.Assertions["sshd config"]
+	if a == nil || a.Kind != FileAttributesAssrt || a.Mode != "0600" || a.Owner != "root" || a.Group != "0" || a.FileType != "file" || a.MinSize != 1 || a.MaxSize != 65536 {
+		t.Fatalf("Incorrect assertion data, got: %s", spew.Sdump(a))
+	}
+	if a2 := spec.Assertions["localtime"]; a2.FileType != "symlink" || a2.LinkTarget != "/usr/share/zoneinfo/UTC" {
+		t.Errorf("Incorrect assertion data, got: %s", spew.Sdump(a2))
+	}
+}
+
+func TestBadFileAttributesAssertionErrors(t *testing.T) {
+	_, err := ParseAssertionsSpecFile("testdata/assertions/badFileAttributes.hcl")
+	if err == nil {
+		t.Fatal("Expected error")
+	}
+	if err.Error() != "link_target is only valid when file_type is symlink" {
+		t.Errorf("Got %q, Want 'link_target is only valid when file_type is symlink'", err)
+	}
+}
+
+func TestFilesystemActionParse(t *testing.T) {
+	spec, err := ParseAssertionsSpecFile("testdata/actions/filesystem.hcl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	actions := spec.Assertions["data dir"].Actions
+	if len(actions) != 3 {
+		t.Fatalf("Got %d actions, wanted 3", len(actions))
+	}
+	// path, mode, owner & group should default to those of the assertion
+	if a := actions[0]; a.Kind != ActionMkdir || a.Path != "/srv/data" || a.Mode != "0750" || !a.Parents {
+		t.Errorf("Incorrect action data, got: %s", spew.Sdump(a))
+	}
+	if a := actions[1]; a.Kind != ActionChmod || a.Path != "/srv/data" || a.Mode != "0750" {
+		t.Errorf("Incorrect action data, got: %s", spew.Sdump(a))
+	}
+	if a := actions[2]; a.Kind != ActionChown || a.Owner != "app" || a.Group != "app" {
+		t.Errorf("Incorrect action data, got: %s", spew.Sdump(a))
+	}
+	if a := spec.Assertions["current release"].Actions[0]; a.Kind != ActionSymlink || a.Path != "/srv/current" || a.Target != "/srv/releases/2" {
+		t.Errorf("Incorrect action data, got: %s", spew.Sdump(a))
+	}
+	if a := spec.Assertions["no stale cache"].Actions[0]; a.Kind != ActionDelete || a.Path != "/srv/cache" || !a.Recursive {
+		t.Errorf("Incorrect action data, got: %s", spew.Sdump(a))
+	}
+}
+
+func TestBadChownActionErrors(t *testing.T) {
+	_, err := ParseAssertionsSpecFile("testdata/actions/badChown.hcl")
+	if err == nil {
+		t.Fatal("Expected error")
+	}
+	if err.Error() != "owner or group must be specified for CHOWN actions" {
+		t.Errorf("Got %q, Want 'owner or group must be specified for CHOWN actions'", err)
+	}
+}
+
+func TestHashAlgorithmParse(t *testing.T) {
+	spec, err := ParseAssertionsSpecFile("testdata/assertions/hashAlgorithms.hcl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a := spec.Assertions["motd"]; a.HashAlgorithm != "sha256" {
+		t.Errorf("Got hash_algorithm=%q, wanted 'sha256'", a.HashAlgorithm)
+	}
+	if a := spec.Assertions["sshd config"]; a.HashAlgorithm != "blake2b" {
+		t.Errorf("Got hash_algorithm=%q, wanted 'blake2b'", a.HashAlgorithm)
+	}
+}
+
+func TestBadHashAlgorithmErrors(t *testing.T) {
+	_, err := ParseAssertionsSpecFile("testdata/assertions/badHashAlgorithm.hcl")
+	if err == nil {
+		t.Fatal("Expected error")
+	}
+	if err.Error() != "hash_algorithm must be one of md5/sha1/sha256/sha512/blake2b" {
+		t.Errorf("Got %q, Want 'hash_algorithm must be one of md5/sha1/sha256/sha512/blake2b'", err)
+	}
+}
+
+func TestTemplateParse(t *testing.T) {
+	spec, err := ParseAssertionsSpecFile("testdata/assertions/template.hcl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := spec.Assertions["nginx config"]
+	if a.Kind != TemplateMatchAssrt || a.Template != "templates/app.conf.tmpl" || a.FilePath != "/etc/nginx/conf.d/app.conf" {
+		t.Fatalf("Incorrect assertion data, got: %s", spew.Sdump(a))
+	}
+	// template & destination_path should default to those of the assertion
+	if action := a.Actions[0]; action.Kind != ActionTemplate || action.Template != a.Template || action.DestinationPath != a.FilePath || action.Mode != "0644" {
+		t.Errorf("Incorrect action data, got: %s", spew.Sdump(action))
+	}
+}
+
+func TestBadTemplateActionErrors(t *testing.T) {
+	_, err := ParseAssertionsSpecFile("testdata/actions/badTemplate.hcl")
+	if err == nil {
+		t.Fatal("Expected error")
+	}
+	if err.Error() != "template/destination_path must be specified for TEMPLATE actions" {
+		t.Errorf("Got %q, Want 'template/destination_path must be specified for TEMPLATE actions'", err)
+	}
+}
+
+func TestAssertionSpecForMachine(t *testing.T) {
+	spec, err := ParseAssertionsSpecFile("testdata/assertions/variables.hcl")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := &Machine{Kind: KindLocal, Vars: map[string]string{"prefix": "/opt/app"}}
+	out, err := spec.ForMachine("web-1", m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := out.Assertions["app binary"]
+	// the machine's vars should override the spec's variables
+	if a.FilePath != "/opt/app/bin/app" || a.Mode != "0755" || a.Actions[0].Command != "make install PREFIX=/opt/app HOST=web-1" {
+		t.Errorf("Incorrect assertion data, got: %s", spew.Sdump(a))
+	}
+	// references without a var., machine. or env. prefix are left for the shell
+	if a.Actions[1].Command != "ln -sf /opt/app/bin/app ${HOME}/bin/app" {
+		t.Errorf("Incorrect command, got %q", a.Actions[1].Command)
+	}
+	// the original spec should be unchanged
+	if spec.Assertions["app binary"].FilePath != "${var.prefix}/bin/app" {
+		t.Errorf("Spec was modified, got file_path=%q", spec.Assertions["app binary"].FilePath)
+	}
+
+	m.Vars["mode"] = "rwxr-xr-x"
+	if _, err = spec.ForMachine("web-1", m); err == nil || !strings.HasPrefix(err.Error(), "variables: mode must be octal permissions") {
+		t.Errorf("Got %v, want 'variables: mode must be octal permissions ...'", err)
+	}
+}
+
+func TestBadVariableReferenceErrors(t *testing.T) {
+	_, err := ParseAssertionsSpecFile("testdata/assertions/badVariable.hcl")
+	if err == nil || err.Error() != "app config: undefined variable: config_dir" {
+		t.Errorf("Got %v, want 'app config: undefined variable: config_dir'", err)
+	}
+
+	_, err = ParseAssertionsSpecFile("testdata/assertions/badMachineAttribute.hcl")
+	if err == nil || err.Error() != "hostname: unknown machine attribute: hostname" {
+		t.Errorf("Got %v, want 'hostname: unknown machine attribute: hostname'", err)
+	}
+}
+
+func TestDependencies(t *testing.T) {
+	spec, err := ParseAssertionsSpecFile("testdata/assertions/dependencies.hcl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deps := spec.Assertions["running"].DependsOn; len(deps) != 2 || deps[0] != "deps.config" {
+		t.Errorf("Got depends_on=%v, wanted [deps.config other.firewall]", deps)
+	}
+	// The assertion graph treats an unset depends_on differently from an empty one.
+	if deps := spec.Assertions["package"].DependsOn; deps != nil {
+		t.Errorf("Got depends_on=%#v for an assertion without depends_on, wanted nil", deps)
+	}
+	if deps := spec.Assertions["kernel"].DependsOn; deps == nil || len(deps) != 0 {
+		t.Errorf("Got depends_on=%#v for depends_on = [], wanted an empty list", deps)
+	}
+
+	err = CheckDependencies([]*AssertionSpec{spec})
+	if err == nil || err.Error() != "deps.running: depends_on references unknown assertion: other.firewall" {
+		t.Errorf("Got %v, want 'deps.running: depends_on references unknown assertion: other.firewall'", err)
+	}
+
+	other := &AssertionSpec{Name: "other", Assertions: map[string]*Assertion{"firewall": &Assertion{Kind: FileExistsAssrt}}}
+	if err = CheckDependencies([]*AssertionSpec{spec, other}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDependencyCycleErrors(t *testing.T) {
+	spec, err := ParseAssertionsSpecFile("testdata/assertions/dependencyCycle.hcl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = CheckDependencies([]*AssertionSpec{spec})
+	if err == nil || err.Error() != "depends_on references form a cycle: cycle.a -> cycle.c -> cycle.b -> cycle.a" {
+		t.Errorf("Got %v, want 'depends_on references form a cycle: cycle.a -> cycle.c -> cycle.b -> cycle.a'", err)
+	}
+}
+
+func TestOnFailureParse(t *testing.T) {
+	spec, err := ParseAssertionsSpecFile("testdata/assertions/onFailure.hcl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a := spec.Assertions["optional"]; !a.IgnoreErrors || a.OnFailure != OnFailureStop {
+		t.Errorf("Incorrect assertion data, got: %s", spew.Sdump(a))
+	}
+	if a := spec.Assertions["keep going"]; a.IgnoreErrors || a.OnFailure != OnFailureContinue {
+		t.Errorf("Incorrect assertion data, got: %s", spew.Sdump(a))
+	}
+	// on_failure should default to stop
+	if a := spec.Assertions["default"]; a.OnFailure != OnFailureStop {
+		t.Errorf("Got on_failure=%q, wanted 'stop'", a.OnFailure)
+	}
+
+	_, err = ParseAssertionsSpecFile("testdata/assertions/badOnFailure.hcl")
+	if err == nil || err.Error() != "on_failure must be one of stop/continue" {
+		t.Errorf("Got %v, want 'on_failure must be one of stop/continue'", err)
+	}
+}
+
+func TestTagsAssertionsParse(t *testing.T) {
+	spec, err := ParseAssertionsSpecFile("testdata/assertions/tags.hcl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tags := spec.Assertions["nginx installed"].Tags; len(tags) != 2 || tags[0] != "nginx" || tags[1] != "packages" {
+		t.Errorf("Got tags=%v, wanted [nginx packages]", tags)
+	}
+	if tags := spec.Assertions["motd"].Tags; len(tags) != 0 {
+		t.Errorf("Got tags=%v, wanted none", tags)
+	}
+
+	specs := []*AssertionSpec{spec}
+	if s, name, ok := ResolveAssertion(specs, "tagged.nginx config"); !ok || s != spec || name != "nginx config" {
+		t.Errorf("Got %v, %q, %v for tagged.nginx config", s, name, ok)
+	}
+	if _, _, ok := ResolveAssertion(specs, "nginx config"); ok {
+		t.Error("Expected unqualified reference to not resolve")
+	}
+}
@@ -2,14 +2,37 @@ package config
 
 import (
 	"errors"
-	"io/ioutil"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/hcl"
 	"github.com/hashicorp/hcl/hcl/ast"
 )
 
 // ParseAssertionsSchema takes a target configuration and translates it into in-memory structures.
+// Included files are resolved relative to the current directory.
 func ParseAssertionsSchema(data []byte) (*AssertionSpec, error) {
+	spec, err := decodeAssertionsSchema(data)
+	if err != nil {
+		return nil, err
+	}
+	if err = resolveIncludes(spec, "", nil, map[string]bool{}); err != nil {
+		return nil, err
+	}
+	return finishAssertionSpec(spec)
+}
+
+// ParseAssertionsSpecFile parses the assertions file from disk, along with any files it includes.
+func ParseAssertionsSpecFile(fpath string) (*AssertionSpec, error) {
+	spec, err := loadAssertionsFile(fpath, nil, map[string]bool{})
+	if err != nil {
+		return nil, err
+	}
+	return finishAssertionSpec(spec)
+}
+
+func decodeAssertionsSchema(data []byte) (*AssertionSpec, error) {
 	astRoot, err := hcl.ParseBytes(data)
 	if err != nil {
 		return nil, err
@@ -24,23 +47,18 @@ func ParseAssertionsSchema(data []byte) (*AssertionSpec, error) {
 	if err != nil {
 		return nil, err
 	}
-
-	normaliseAssertionSpec(&outSpec)
-	err = checkAssertionSpec(&outSpec)
-	if err != nil {
-		return nil, err
-	}
 	return &outSpec, nil
 }
 
-// ParseAssertionsSpecFile parses the assertions file from disk.
-func ParseAssertionsSpecFile(fpath string) (*AssertionSpec, error) {
-	d, err := ioutil.ReadFile(fpath)
-	if err != nil {
+func finishAssertionSpec(spec *AssertionSpec) (*AssertionSpec, error) {
+	normaliseAssertionSpec(spec)
+	if err := checkAssertionSpec(spec); err != nil {
 		return nil, err
 	}
-
-	return ParseAssertionsSchema(d)
+	if err := checkAssertionReferences(spec.Assertions, spec.Variables); err != nil {
+		return nil, err
+	}
+	return spec, nil
 }
 
 func normaliseAssertionSpec(spec *AssertionSpec) {
@@ -53,6 +71,12 @@ func normaliseAssertionSpec(spec *AssertionSpec) {
 }
 
 func normaliseAssertion(assertion *Assertion) {
+	if assertion.OnFailure == "" {
+		assertion.OnFailure = OnFailureStop
+	}
+	if assertion.Kind == CommandAssrt && len(assertion.ExitCodes) == 0 {
+		assertion.ExitCodes = []int{0}
+	}
 	if len(assertion.Actions) == 0 {
 		assertion.Actions = []*Action{&Action{Kind: ActionFail}}
 	} else {
@@ -63,12 +87,56 @@ func normaliseAssertion(assertion *Assertion) {
 				for _, assertion := range assertion.Actions[x].Assertions {
 					normaliseAssertion(assertion)
 				}
+			} else if assertion.Actions[x].Kind == ActionRun && len(assertion.Actions[x].ExitCodes) == 0 {
+				assertion.Actions[x].ExitCodes = []int{0}
+			} else if isFilesystemAction(assertion.Actions[x].Kind) {
+				normaliseFilesystemAction(assertion, assertion.Actions[x])
+			} else if assertion.Actions[x].Kind == ActionTemplate {
+				if assertion.Actions[x].Template == "" {
+					assertion.Actions[x].Template = assertion.Template
+				}
+				if assertion.Actions[x].DestinationPath == "" {
+					assertion.Actions[x].DestinationPath = assertion.FilePath
+				}
 			}
 		}
 	}
 }
 
+// normaliseFilesystemAction fills in unset fields of a filesystem action from its assertion.
+func normaliseFilesystemAction(assertion *Assertion, action *Action) {
+	if action.Path == "" {
+		action.Path = assertion.FilePath
+	}
+	switch action.Kind {
+	case ActionMkdir, ActionChmod:
+		if action.Mode == "" {
+			action.Mode = assertion.Mode
+		}
+	case ActionSymlink:
+		if action.Target == "" {
+			action.Target = assertion.LinkTarget
+		}
+	case ActionChown:
+		if action.Owner == "" && action.Group == "" {
+			action.Owner, action.Group = assertion.Owner, assertion.Group
+		}
+	}
+}
+
+func isFilesystemAction(kind string) bool {
+	switch kind {
+	case ActionMkdir, ActionDelete, ActionSymlink, ActionChmod, ActionChown:
+		return true
+	}
+	return false
+}
+
 func checkAssertion(a *Assertion) error {
+	if a.OnFailure != OnFailureStop && a.OnFailure != OnFailureContinue && !hasInterpolation(a.OnFailure) {
+		return errors.New("on_failure must be one of " + OnFailureStop + "/" + OnFailureContinue)
+	}
+
 	switch a.Kind {
 	case FileExistsAssrt:
 		fallthrough
@@ -80,14 +148,72 @@ func checkAssertion(a *Assertion) error {
 		if a.Hash == "" || a.FilePath == "" {
 			return errors.New("hash/file_path must be specified for md5_match assertions")
 		}
+		if !isHashAlgorithm(a.HashAlgorithm) {
+			return errors.New("hash_algorithm must be one of " + strings.Join(HashAlgorithms, "/"))
+		}
 	case HashFileAssrt:
 		if a.BasePath == "" || a.FilePath == "" {
 			return errors.New("base_path/file_path must be specified for file_match assertions")
 		}
+		if !isHashAlgorithm(a.HashAlgorithm) {
+			return errors.New("hash_algorithm must be one of " + strings.Join(HashAlgorithms, "/"))
+		}
 	case RegexMatchAssrt:
 		if a.Regex == "" {
 			return errors.New("regex must be specified for regex_contents_match assertions")
 		}
+	case PackageInstalledAssrt:
+		if a.Package == "" {
+			return errors.New("package must be specified for package_installed assertions")
+		}
+		if a.Version != "" && !hasInterpolation(a.Version) {
+			if _, err := ParseVersionConstraint(a.Version); err != nil {
+				return err
+			}
+		}
+	case CommandAssrt:
+		if a.Command == "" {
+			return errors.New("command must be specified for command assertions")
+		}
+		if a.Timeout != "" && !hasInterpolation(a.Timeout) {
+			if _, err := time.ParseDuration(a.Timeout); err != nil {
+				return errors.New("invalid timeout for command assertion: " + a.Timeout)
+			}
+		}
+		for _, r := range []string{a.StdoutRegex, a.StderrRegex} {
+			if hasInterpolation(r) {
+				continue
+			}
+			if _, err := regexp.Compile(r); err != nil {
+				return errors.New("invalid regex for command assertion: " + err.Error())
+			}
+		}
+	case FileAttributesAssrt:
+		if a.FilePath == "" {
+			return errors.New("file_path must be specified for file_attributes assertions")
+		}
+		if a.Mode != "" && !hasInterpolation(a.Mode) {
+			if _, err := ParseMode(a.Mode); err != nil {
+				return err
+			}
+		}
+		if a.FileType != "" && !isFileType(a.FileType) {
+			return errors.New("file_type must be one of " + strings.Join(FileTypes, "/") + " for file_attributes assertions")
+		}
+		if a.LinkTarget != "" && a.FileType != "symlink" && !hasInterpolation(a.FileType) {
+			return errors.New("link_target is only valid when file_type is symlink")
+		}
+		if a.MinSize < 0 || a.MaxSize < 0 || (a.MaxSize > 0 && a.MinSize > a.MaxSize) {
+			return errors.New("invalid min_size/max_size for file_attributes assertion")
+		}
+	case TemplateMatchAssrt:
+		if a.Template == "" || a.FilePath == "" {
+			return errors.New("template/file_path must be specified for template_match assertions")
+		}
+	case ServiceRunningAssrt, ServiceEnabledAssrt:
+		if a.Service == "" {
+			return errors.New("service must be specified for service_running and service_enabled assertions")
+		}
 	default:
 		return errors.New("unsupported assertion type/kind: " + a.Kind)
 	}
@@ -103,6 +229,58 @@ func checkAssertion(a *Assertion) error {
 			if action.SourcePath == "" || action.DestinationPath == "" {
 				return errors.New("source_path/destination_path must be specified for COPY actions")
 			}
+			if action.Mode != "" && !hasInterpolation(action.Mode) {
+				if _, err := ParseMode(action.Mode); err != nil {
+					return err
+				}
+			}
+		case ActionTemplate:
+			if action.Template == "" || action.DestinationPath == "" {
+				return errors.New("template/destination_path must be specified for TEMPLATE actions")
+			}
+			if action.Mode != "" && !hasInterpolation(action.Mode) {
+				if _, err := ParseMode(action.Mode); err != nil {
+					return err
+				}
+			}
+		case ActionInstallPackage:
+			if action.Package == "" && a.Package == "" {
+				return errors.New("package must be specified for INSTALL_PACKAGE actions")
+			}
+		case ActionService:
+			if action.Service == "" && a.Service == "" {
+				return errors.New("service must be specified for SERVICE actions")
+			}
+			if !isServiceOperation(action.Operation) {
+				return errors.New("operation must be one of " + strings.Join(ServiceOperations, "/") + " for SERVICE actions")
+			}
+		case ActionRun:
+			if action.Command == "" {
+				return errors.New("command must be specified for RUN actions")
+			}
+			if action.Timeout != "" && !hasInterpolation(action.Timeout) {
+				if _, err := time.ParseDuration(action.Timeout); err != nil {
+					return errors.New("invalid timeout for RUN action: " + action.Timeout)
+				}
+			}
+		case ActionMkdir, ActionDelete, ActionSymlink, ActionChmod, ActionChown:
+			if action.Path == "" {
+				return errors.New("path must be specified for " + action.Kind + " actions")
+			}
+			if action.Kind == ActionChmod && action.Mode == "" {
+				return errors.New("mode must be specified for CHMOD actions")
+			}
+			if action.Mode != "" && !hasInterpolation(action.Mode) {
+				if _, err := ParseMode(action.Mode); err != nil {
+					return err
+				}
+			}
+			if action.Kind == ActionSymlink && action.Target == "" {
+				return errors.New("target must be specified for SYMLINK actions")
+			}
+			if action.Kind == ActionChown && action.Owner == "" && action.Group == "" {
+				return errors.New("owner or group must be specified for CHOWN actions")
+			}
 		default:
 			return errors.New("unsupported action type/kind: " + action.Kind)
 		}
@@ -110,6 +288,42 @@ func checkAssertion(a *Assertion) error {
 	return nil
 }
 
+func isServiceOperation(op string) bool {
+	if hasInterpolation(op) {
+		return true
+	}
+	for _, o := range ServiceOperations {
+		if op == o {
+			return true
+		}
+	}
+	return false
+}
+
+func isHashAlgorithm(algorithm string) bool {
+	if algorithm == "" || hasInterpolation(algorithm) {
+		return true
+	}
+	for _, a := range HashAlgorithms {
+		if algorithm == a {
+			return true
+		}
+	}
+	return false
+}
+
+func isFileType(t string) bool {
+	if hasInterpolation(t) {
+		return true
+	}
+	for _, ft := range FileTypes {
+		if t == ft {
+			return true
+		}
+	}
+	return false
+}
+
 func checkAssertionSpec(spec *AssertionSpec) error {
 	for name, a := range spec.Assertions {
 		if name == "" {
@@ -0,0 +1,82 @@
+package config
+
+import (
+	"errors"
+	"sort"
+	"strings"
+)
+
+// ResolveAssertion finds the assertion referenced by its fully qualified name (<spec name>.<assertion name>).
+func ResolveAssertion(specs []*AssertionSpec, ref string) (*AssertionSpec, string, bool) {
+	for _, spec := range specs {
+		if name := strings.TrimPrefix(ref, spec.Name+"."); name != ref {
+			if _, ok := spec.Assertions[name]; ok {
+				return spec, name, true
+			}
+		}
+	}
+	return nil, "", false
+}
+
+// ResolveDependency finds the assertion referenced by a depends_on entry of an assertion in spec from.
+// References are either fully qualified (<spec name>.<assertion name>) or the name of another assertion in from.
+func ResolveDependency(specs []*AssertionSpec, from *AssertionSpec, ref string) (*AssertionSpec, string, error) {
+	if spec, name, ok := ResolveAssertion(specs, ref); ok {
+		return spec, name, nil
+	}
+	if _, ok := from.Assertions[ref]; ok {
+		return from, ref, nil
+	}
+	return nil, "", errors.New("depends_on references unknown assertion: " + ref)
+}
+
+// CheckDependencies checks the depends_on references of every assertion exist, and do not form a cycle.
+func CheckDependencies(specs []*AssertionSpec) error {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := map[*Assertion]int{}
+	var path []string
+
+	var visit func(spec *AssertionSpec, name string) error
+	visit = func(spec *AssertionSpec, name string) error {
+		a := spec.Assertions[name]
+		path = append(path, spec.Name+"."+name)
+		defer func() { path = path[:len(path)-1] }()
+
+		switch state[a] {
+		case visiting:
+			return errors.New("depends_on references form a cycle: " + strings.Join(path, " -> "))
+		case visited:
+			return nil
+		}
+		state[a] = visiting
+		for _, ref := range a.DependsOn {
+			depSpec, depName, err := ResolveDependency(specs, spec, ref)
+			if err != nil {
+				return errors.New(spec.Name + "." + name + ": " + err.Error())
+			}
+			if err = visit(depSpec, depName); err != nil {
+				return err
+			}
+		}
+		state[a] = visited
+		return nil
+	}
+
+	for _, spec := range specs {
+		names := make([]string, 0, len(spec.Assertions))
+		for name := range spec.Assertions {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if err := visit(spec, name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
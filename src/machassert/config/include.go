@@ -0,0 +1,190 @@
+package config
+
+import (
+	"errors"
+	"io/ioutil"
+	"machassert/util"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// loadAssertionsFile decodes the assertions file at fpath, resolving its includes & modules.
+// stack holds the absolute paths of the files currently being loaded, to detect cycles, and
+// loaded the absolute paths of the files already included, which are not included again.
+func loadAssertionsFile(fpath string, stack []string, loaded map[string]bool) (*AssertionSpec, error) {
+	abs, err := filepath.Abs(fpath)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range stack {
+		if p == abs {
+			return nil, errors.New("include cycle: " + strings.Join(append(stack, abs), " -> "))
+		}
+	}
+
+	d, err := ioutil.ReadFile(fpath)
+	if err != nil {
+		return nil, err
+	}
+	spec, err := decodeAssertionsSchema(d)
+	if err != nil {
+		return nil, err
+	}
+	if err = resolveIncludes(spec, fpath, append(stack, abs), loaded); err != nil {
+		return nil, err
+	}
+	loaded[abs] = true
+	return spec, nil
+}
+
+// resolveIncludes merges the assertions & variables of included files into spec, and instantiates its modules.
+// Paths are relative to the directory of from, the file spec was loaded from. Files which were already included
+// (by spec, or another file included by the same file) are skipped, as their assertions have already been merged.
+func resolveIncludes(spec *AssertionSpec, from string, stack []string, loaded map[string]bool) error {
+	if spec.Assertions == nil {
+		spec.Assertions = map[string]*Assertion{}
+	}
+	if spec.Variables == nil {
+		spec.Variables = map[string]*Variable{}
+	}
+
+	for _, include := range spec.Include {
+		fpath := includePath(from, include)
+		abs, err := filepath.Abs(fpath)
+		if err != nil {
+			return includeError(from, "include "+include+": "+err.Error())
+		}
+		if loaded[abs] {
+			continue
+		}
+		included, err := loadAssertionsFile(fpath, stack, loaded)
+		if err != nil {
+			return includeError(from, "include "+include+": "+err.Error())
+		}
+		for name, v := range included.Variables {
+			if _, exists := spec.Variables[name]; !exists {
+				spec.Variables[name] = v
+			}
+		}
+		for name, a := range included.Assertions {
+			if _, exists := spec.Assertions[name]; exists {
+				return includeError(from, "include "+include+": assertion is already defined: "+name)
+			}
+			spec.Assertions[name] = a
+		}
+	}
+
+	moduleNames := make([]string, 0, len(spec.Modules))
+	for name := range spec.Modules {
+		moduleNames = append(moduleNames, name)
+	}
+	sort.Strings(moduleNames)
+
+	for _, moduleName := range moduleNames {
+		if err := instantiateModule(spec, moduleName, from, stack); err != nil {
+			return includeError(from, "module "+moduleName+": "+err.Error())
+		}
+	}
+	return nil
+}
+
+// instantiateModule adds the assertions of the module's source file to spec, named <module name>.<assertion name>.
+// References to the variables of the source file are replaced with the module's arguments (or the variable's default),
+// and depends_on references to assertions of the source file are renamed to match. Variables without a default must
+// be given as arguments. Each module loads its source (and the files it includes) afresh.
+func instantiateModule(spec *AssertionSpec, moduleName, from string, stack []string) error {
+	module := spec.Modules[moduleName]
+	if module.Source == "" {
+		return errors.New("source must be specified")
+	}
+	source, err := loadAssertionsFile(includePath(from, module.Source), stack, map[string]bool{})
+	if err != nil {
+		return err
+	}
+
+	if err = checkAssertionReferences(source.Assertions, source.Variables); err != nil {
+		return err
+	}
+
+	scope := &Scope{Vars: map[string]string{}, partial: true}
+	for name, v := range source.Variables {
+		if v.HasDefault() {
+			scope.Vars[name] = v.Default
+		}
+	}
+	for name, arg := range module.Args {
+		if _, declared := source.Variables[name]; !declared {
+			return errors.New("unknown argument: " + name)
+		}
+		scope.Vars[name] = arg
+	}
+	for _, name := range sortedVariables(source.Variables) {
+		if _, ok := scope.Vars[name]; !ok {
+			return errors.New("missing argument: " + name)
+		}
+	}
+
+	for name, a := range source.Assertions {
+		out, err := interpolateValue(reflect.ValueOf(a), scope)
+		if err != nil {
+			return err
+		}
+		fullName := moduleName + "." + name
+		if _, exists := spec.Assertions[fullName]; exists {
+			return errors.New("assertion is already defined: " + fullName)
+		}
+		instance := out.Interface().(*Assertion)
+		instance.DependsOn = moduleDependsOn(source, moduleName, instance.DependsOn)
+		spec.Assertions[fullName] = instance
+	}
+	return nil
+}
+
+// moduleDependsOn returns the depends_on references of an assertion instantiated from source by the named module.
+// References to assertions of source, either by name or as <source spec name>.<assertion name>, become
+// <module name>.<assertion name>. Other references are unchanged.
+func moduleDependsOn(source *AssertionSpec, moduleName string, dependsOn []string) []string {
+	if dependsOn == nil {
+		return nil
+	}
+	out := make([]string, len(dependsOn))
+	for i, ref := range dependsOn {
+		out[i] = ref
+		if _, ok := source.Assertions[ref]; ok {
+			out[i] = moduleName + "." + ref
+		} else if name := strings.TrimPrefix(ref, source.Name+"."); source.Name != "" && name != ref {
+			if _, ok := source.Assertions[name]; ok {
+				out[i] = moduleName + "." + name
+			}
+		}
+	}
+	return out
+}
+
+// includePath returns the path of an included file, relative to the directory of the file including it.
+func includePath(from, fpath string) string {
+	fpath = util.PathSanitize(fpath)
+	if filepath.IsAbs(fpath) {
+		return fpath
+	}
+	return filepath.Join(filepath.Dir(from), fpath)
+}
+
+// includeError returns an error naming the file which included (or instantiated) the failing file, if known.
+func includeError(from, msg string) error {
+	if from == "" {
+		return errors.New(msg)
+	}
+	return errors.New(from + ": " + msg)
+}
+
+func sortedVariables(vars map[string]*Variable) []string {
+	out := make([]string, 0, len(vars))
+	for name := range vars {
+		out = append(out, name)
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,83 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/davecgh/go-spew/spew"
+)
+
+func TestIncludeParse(t *testing.T) {
+	spec, err := ParseAssertionsSpecFile("testdata/includes/main.hcl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(spec.Assertions) != 4 {
+		t.Fatalf("Got %d assertions, wanted 4: %s", len(spec.Assertions), spew.Sdump(spec.Assertions))
+	}
+	if a := spec.Assertions["motd"]; a == nil || a.FilePath != "/etc/motd" || a.Order != 1 {
+		t.Errorf("Incorrect included assertion, got: %s", spew.Sdump(a))
+	}
+	// variables of the including file take precedence
+	if spec.Variables["prefix"].Default != "/opt/web" || spec.Variables["admin"].Default != "root" {
+		t.Errorf("Incorrect variables, got: %s", spew.Sdump(spec.Variables))
+	}
+
+	// module variables are substituted at parse time, other references are left for ForMachine
+	a := spec.Assertions["ssh.config permissions"]
+	if a == nil || a.FilePath != "/etc/ssh/sshd_config" || a.Mode != "0600" || a.Owner != "${var.admin}" {
+		t.Fatalf("Incorrect module assertion, got: %s", spew.Sdump(a))
+	}
+	if a.Actions[0].Mode != "0600" || a.Actions[0].Path != "/etc/ssh/sshd_config" {
+		t.Errorf("Incorrect module action, got: %s", spew.Sdump(a.Actions[0]))
+	}
+	// depends_on references within the module are renamed along with the assertions
+	if len(a.DependsOn) != 1 || a.DependsOn[0] != "ssh.config exists" {
+		t.Errorf("Incorrect module depends_on, got: %v", a.DependsOn)
+	}
+	if spec.Assertions["ssh.config exists"].DependsOn != nil {
+		t.Errorf("Expected nil depends_on, got: %v", spec.Assertions["ssh.config exists"].DependsOn)
+	}
+	if err = CheckDependencies([]*AssertionSpec{spec}); err != nil {
+		t.Errorf("CheckDependencies() failed: %v", err)
+	}
+
+	out, err := spec.ForMachine("local", &Machine{Kind: KindLocal})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.Assertions["ssh.config permissions"].Owner != "root" || out.Assertions["app"].FilePath != "/opt/web/bin/app" {
+		t.Errorf("Incorrect interpolated assertions, got: %s", spew.Sdump(out.Assertions))
+	}
+}
+
+func TestDiamondIncludeParse(t *testing.T) {
+	spec, err := ParseAssertionsSpecFile("testdata/includes/diamond.hcl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// lib/common.hcl is included by both lib/web.hcl and lib/db.hcl, but only merged once
+	if len(spec.Assertions) != 3 || spec.Assertions["motd"] == nil {
+		t.Errorf("Got %d assertions, wanted nginx, postgres & motd: %s", len(spec.Assertions), spew.Sdump(spec.Assertions))
+	}
+	if spec.Variables["admin"] == nil {
+		t.Errorf("Expected variables of lib/common.hcl, got: %s", spew.Sdump(spec.Variables))
+	}
+}
+
+func TestIncludeErrors(t *testing.T) {
+	_, err := ParseAssertionsSpecFile("testdata/includes/cycle_a.hcl")
+	if err == nil || !strings.HasPrefix(err.Error(), "testdata/includes/cycle_a.hcl: include cycle_b.hcl: testdata/includes/cycle_b.hcl: include cycle_a.hcl: include cycle: ") {
+		t.Errorf("Got %v, want include cycle error", err)
+	}
+
+	_, err = ParseAssertionsSpecFile("testdata/includes/bad_module.hcl")
+	if err == nil || err.Error() != "testdata/includes/bad_module.hcl: module ssh: unknown argument: config_pth" {
+		t.Errorf("Got %v, want 'testdata/includes/bad_module.hcl: module ssh: unknown argument: config_pth'", err)
+	}
+
+	_, err = ParseAssertionsSpecFile("testdata/includes/missing_module_arg.hcl")
+	if err == nil || err.Error() != "testdata/includes/missing_module_arg.hcl: module ssh: missing argument: config_path" {
+		t.Errorf("Got %v, want 'testdata/includes/missing_module_arg.hcl: module ssh: missing argument: config_path'", err)
+	}
+}
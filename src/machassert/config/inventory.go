@@ -0,0 +1,153 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+)
+
+// inventory describes the schema for a JSON inventory, which is converted to a MachineSpec.
+type inventory struct {
+	Name        string                       `json:"name"`
+	Parallelism int                          `json:"parallelism"`
+	Variables   map[string]string            `json:"variables"` // defaults for every machine
+	Groups      map[string]*inventoryGroup   `json:"groups"`
+	Machines    map[string]*inventoryMachine `json:"machines"`
+}
+
+type inventoryGroup struct {
+	Machines []string `json:"machines"`
+	Groups   []string `json:"groups"`
+}
+
+type inventoryMachine struct {
+	Kind         string            `json:"kind"`
+	Destination  string            `json:"destination"`
+	Username     string            `json:"username"`
+	Auth         []inventoryAuth   `json:"auth"`
+	Tags         []string          `json:"tags"`
+	Vars         map[string]string `json:"vars"`
+	ForwardAgent bool              `json:"forward_agent"`
+	HostKey      string            `json:"host_key"`
+	KnownHosts   string            `json:"known_hosts"`
+	Via          []*inventoryHop   `json:"via"`
+	Become       *inventoryBecome  `json:"become"`
+}
+
+// inventoryAuth references the credentials used to connect to a machine, such as a key file or the ssh-agent.
+type inventoryAuth struct {
+	Kind     string `json:"kind"`
+	Key      string `json:"key"`
+	Password string `json:"password"`
+}
+
+type inventoryHop struct {
+	Machine     string          `json:"machine"`
+	Destination string          `json:"destination"`
+	Username    string          `json:"username"`
+	Auth        []inventoryAuth `json:"auth"`
+	HostKey     string          `json:"host_key"`
+	KnownHosts  string          `json:"known_hosts"`
+}
+
+type inventoryBecome struct {
+	Method string `json:"method"`
+	User   string `json:"user"`
+	Prompt bool   `json:"prompt"`
+}
+
+// ParseInventory takes a JSON inventory and translates it into a MachineSpec, which is validated in
+// the same way as a targets file. Unknown fields are errors, so typos are not silently ignored.
+func ParseInventory(data []byte) (*MachineSpec, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	var inv inventory
+	if err := dec.Decode(&inv); err != nil {
+		return nil, errors.New("inventory: " + err.Error())
+	}
+
+	spec := &MachineSpec{
+		Name:        inv.Name,
+		Parallelism: inv.Parallelism,
+		Machine:     map[string]*Machine{},
+	}
+	if len(inv.Variables) > 0 {
+		spec.Variables = map[string]*Variable{}
+		for k, v := range inv.Variables {
+			spec.Variables[k] = &Variable{Default: v}
+		}
+	}
+	if len(inv.Groups) > 0 {
+		spec.Groups = map[string]*Group{}
+		for name, g := range inv.Groups {
+			if g == nil {
+				return nil, errors.New("inventory: group " + name + " is null")
+			}
+			spec.Groups[name] = &Group{Machines: g.Machines, Groups: g.Groups}
+		}
+	}
+	for name, m := range inv.Machines {
+		if m == nil {
+			return nil, errors.New("inventory: machine " + name + " is null")
+		}
+		spec.Machine[name] = m.machine()
+	}
+
+	return finishMachineSpec(spec)
+}
+
+func (m *inventoryMachine) machine() *Machine {
+	out := &Machine{
+		Kind:         m.Kind,
+		Destination:  m.Destination,
+		Username:     m.Username,
+		Auth:         inventoryAuths(m.Auth),
+		Tags:         m.Tags,
+		Vars:         m.Vars,
+		ForwardAgent: m.ForwardAgent,
+		HostKey:      m.HostKey,
+		KnownHosts:   m.KnownHosts,
+	}
+	for _, hop := range m.Via {
+		out.Via = append(out.Via, &JumpHost{
+			Machine:     hop.Machine,
+			Destination: hop.Destination,
+			Username:    hop.Username,
+			Auth:        inventoryAuths(hop.Auth),
+			HostKey:     hop.HostKey,
+			KnownHosts:  hop.KnownHosts,
+		})
+	}
+	if m.Become != nil {
+		out.Become = &Become{Method: m.Become.Method, User: m.Become.User, Prompt: m.Become.Prompt}
+	}
+	return out
+}
+
+func inventoryAuths(auths []inventoryAuth) []MachineAuth {
+	var out []MachineAuth
+	for _, a := range auths {
+		out = append(out, MachineAuth{Kind: a.Kind, Key: a.Key, Password: a.Password})
+	}
+	return out
+}
+
+// runInventory runs an executable inventory, and parses its output as a JSON inventory.
+// The stderr of the executable is passed through.
+func runInventory(fpath string) (*MachineSpec, error) {
+	// exec.Command looks up names without a separator in $PATH, but fpath is always a file path.
+	abs, err := filepath.Abs(fpath)
+	if err != nil {
+		return nil, err
+	}
+	cmd := exec.Command(abs)
+	cmd.Stderr = os.Stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, errors.New("running inventory " + fpath + ": " + err.Error())
+	}
+	return ParseInventory(out)
+}
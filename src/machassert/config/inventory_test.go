@@ -0,0 +1,73 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/davecgh/go-spew/spew"
+)
+
+func TestInventoryParse(t *testing.T) {
+	spec, err := ParseTargetSpecFile("testdata/targets/inventory.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if spec.Name != "Asset DB" || spec.Parallelism != 2 || len(spec.Machine) != 3 {
+		t.Errorf("Incorrect spec, got: %s", spew.Sdump(spec))
+	}
+
+	web1 := spec.Machine["web-1"]
+	if web1.Kind != KindSSH || web1.Destination != "10.0.1.1" || web1.Username != "deploy" {
+		t.Errorf("Incorrect data, got: %s", spew.Sdump(web1))
+	}
+	if len(web1.Auth) != 1 || web1.Auth[0].Kind != AuthKindKeyFile || web1.Auth[0].Key != "~/.ssh/deploy" {
+		t.Errorf("Incorrect auth data, got: %s", spew.Sdump(web1.Auth))
+	}
+	// variables of the inventory should be merged into the vars of each machine
+	if web1.Vars["listen_port"] != "8080" || web1.Vars["environment"] != "production" {
+		t.Errorf("Incorrect vars, got: %s", spew.Sdump(web1.Vars))
+	}
+	// via references should be resolved, as for targets files
+	if len(web1.Via) != 1 || web1.Via[0].Destination != "bastion.example.com" {
+		t.Errorf("Incorrect hops, got: %s", spew.Sdump(web1.Via))
+	}
+	if b := spec.Machine["web-2"].Become; b == nil || b.Method != BecomeSudo || b.User != "root" {
+		t.Errorf("Incorrect become data, got: %s", spew.Sdump(b))
+	}
+	if got := strings.Join(spec.GroupMachines("web"), ","); got != "web-1,web-2" {
+		t.Errorf("Got web=%s, want web-1,web-2", got)
+	}
+}
+
+func TestExecutableInventoryParse(t *testing.T) {
+	spec, err := ParseTargetSpecFile("exec:testdata/targets/inventory.sh")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db1 := spec.Machine["db-1"]
+	if len(spec.Machine) != 1 || db1 == nil || db1.Destination != "10.0.2.1" || len(db1.Tags) != 1 {
+		t.Errorf("Incorrect data, got: %s", spew.Sdump(spec.Machine))
+	}
+}
+
+func TestInventoryParseErrorCases(t *testing.T) {
+	_, err := ParseTargetSpecFile("testdata/targets/invalid_inventory.json")
+	if err == nil || err.Error() != "key file must be specified for keyfile authentication" {
+		t.Errorf("Got %v, want 'key file must be specified for keyfile authentication'", err)
+	}
+
+	_, err = ParseTargetSpecFile("testdata/targets/invalid_inventory_field.json")
+	if err == nil || err.Error() != `inventory: json: unknown field "destnation"` {
+		t.Errorf(`Got %v, want 'inventory: json: unknown field "destnation"'`, err)
+	}
+
+	// executables are only run with the exec: prefix
+	if _, err = ParseTargetSpecFile("testdata/targets/inventory.sh"); err == nil {
+		t.Error("Expected error parsing an executable inventory without exec:")
+	}
+
+	_, err = ParseTargetSpecFile("exec:testdata/targets/invalid_inventory.sh")
+	if err == nil || err.Error() != "running inventory testdata/targets/invalid_inventory.sh: exit status 3" {
+		t.Errorf("Got %v, want 'running inventory testdata/targets/invalid_inventory.sh: exit status 3'", err)
+	}
+}
@@ -0,0 +1,210 @@
+package config
+
+import (
+	"errors"
+	"path"
+	"sort"
+	"strings"
+)
+
+// tagPrefix prefixes limit terms which select machines by tag.
+const tagPrefix = "tag:"
+
+// validateGroups checks that groups only reference machines and groups which exist, that group
+// references do not form a cycle, and that no group has the same name as a machine.
+func validateGroups(spec *MachineSpec) error {
+	for _, name := range sortedGroups(spec) {
+		if _, ok := spec.Machine[name]; ok {
+			return errors.New("group has the same name as a machine: " + name)
+		}
+		g := spec.Groups[name]
+		for _, m := range g.Machines {
+			if _, ok := spec.Machine[m]; !ok {
+				return errors.New("group " + name + " references unknown machine: " + m)
+			}
+		}
+		for _, child := range g.Groups {
+			if _, ok := spec.Groups[child]; !ok {
+				return errors.New("group " + name + " references unknown group: " + child)
+			}
+		}
+	}
+
+	visiting := map[string]bool{}
+	checked := map[string]bool{}
+	var check func(name string) error
+	check = func(name string) error {
+		if checked[name] {
+			return nil
+		}
+		if visiting[name] {
+			return errors.New("group references form a cycle at group: " + name)
+		}
+		visiting[name] = true
+		for _, child := range spec.Groups[name].Groups {
+			if err := check(child); err != nil {
+				return err
+			}
+		}
+		visiting[name] = false
+		checked[name] = true
+		return nil
+	}
+	for _, name := range sortedGroups(spec) {
+		if err := check(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sortedGroups(spec *MachineSpec) []string {
+	out := make([]string, 0, len(spec.Groups))
+	for name := range spec.Groups {
+		out = append(out, name)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// GroupMachines returns the names of the machines in the group, including those of the groups it contains.
+func (spec *MachineSpec) GroupMachines(group string) []string {
+	set := map[string]bool{}
+	var add func(name string)
+	add = func(name string) {
+		g := spec.Groups[name]
+		for _, m := range g.Machines {
+			set[m] = true
+		}
+		for _, child := range g.Groups {
+			add(child)
+		}
+	}
+	if _, ok := spec.Groups[group]; ok {
+		add(group)
+	}
+	return sortedSet(set)
+}
+
+// MachineGroups returns the names of the groups the machine is in, directly or through a group it is in.
+func (spec *MachineSpec) MachineGroups(machine string) []string {
+	var out []string
+	for _, name := range sortedGroups(spec) {
+		for _, m := range spec.GroupMachines(name) {
+			if m == machine {
+				out = append(out, name)
+				break
+			}
+		}
+	}
+	return out
+}
+
+// SelectMachines returns the names of the machines selected by the limit expression, in name order.
+// The expression is a comma-separated list of terms, each of which is a group name, tag:<tag> for
+// machines with that tag, or a glob matching machine names. The machines selected by the terms are
+// combined, then restricted to those selected by terms prefixed with & and those not selected by terms
+// prefixed with !. If only & and ! terms are given, they apply to every machine. Each term must select
+// at least one machine, so typos are not silently ignored.
+func (spec *MachineSpec) SelectMachines(expr string) ([]string, error) {
+	var include, intersect, exclude []map[string]bool
+	for _, term := range strings.Split(expr, ",") {
+		term = strings.TrimSpace(term)
+		list := &include
+		switch {
+		case strings.HasPrefix(term, "&"):
+			list, term = &intersect, term[1:]
+		case strings.HasPrefix(term, "!"):
+			list, term = &exclude, term[1:]
+		}
+		if term == "" {
+			return nil, errors.New("invalid limit: " + expr)
+		}
+
+		set, err := spec.selectTerm(term)
+		if err != nil {
+			return nil, err
+		}
+		*list = append(*list, set)
+	}
+
+	selected := map[string]bool{}
+	for name := range spec.Machine {
+		selected[name] = len(include) == 0
+		for _, set := range include {
+			selected[name] = selected[name] || set[name]
+		}
+		for _, set := range intersect {
+			selected[name] = selected[name] && set[name]
+		}
+		for _, set := range exclude {
+			selected[name] = selected[name] && !set[name]
+		}
+		if !selected[name] {
+			delete(selected, name)
+		}
+	}
+	if len(selected) == 0 {
+		return nil, errors.New("limit selects no machines: " + expr)
+	}
+	return sortedSet(selected), nil
+}
+
+// selectTerm returns the machines selected by a single limit term.
+func (spec *MachineSpec) selectTerm(term string) (map[string]bool, error) {
+	set := map[string]bool{}
+	switch {
+	case strings.HasPrefix(term, tagPrefix):
+		tag := strings.TrimPrefix(term, tagPrefix)
+		for name, m := range spec.Machine {
+			for _, t := range m.Tags {
+				if t == tag {
+					set[name] = true
+				}
+			}
+		}
+	case spec.Groups[term] != nil:
+		for _, name := range spec.GroupMachines(term) {
+			set[name] = true
+		}
+	default:
+		for name := range spec.Machine {
+			match, err := path.Match(term, name)
+			if err != nil {
+				return nil, errors.New("invalid limit pattern: " + term)
+			}
+			if match {
+				set[name] = true
+			}
+		}
+	}
+
+	if len(set) == 0 {
+		return nil, errors.New("limit matches no machines: " + term)
+	}
+	return set, nil
+}
+
+// Limit returns a copy of the spec containing only the machines selected by the limit expression.
+// See SelectMachines for the syntax of the expression.
+func (spec *MachineSpec) Limit(expr string) (*MachineSpec, error) {
+	names, err := spec.SelectMachines(expr)
+	if err != nil {
+		return nil, err
+	}
+	out := *spec
+	out.Machine = make(map[string]*Machine, len(names))
+	for _, name := range names {
+		out.Machine[name] = spec.Machine[name]
+	}
+	return &out, nil
+}
+
+func sortedSet(set map[string]bool) []string {
+	out := make([]string, 0, len(set))
+	for k := range set {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
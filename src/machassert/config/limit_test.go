@@ -0,0 +1,82 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSelectMachines(t *testing.T) {
+	spec, err := ParseTargetSpecFile("testdata/targets/groups.hcl")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tcs := []struct {
+		limit string
+		want  string
+	}{
+		{"web-1", "web-1"},
+		{"web-*", "web-1,web-2"},
+		{"web", "web-1,web-2"},
+		{"production", "db-1,web-1,web-2,worker-1"},
+		{"tag:nginx", "staging,web-1,web-2"},
+		{"db, web-2", "db-1,web-2"},
+		{"production,&tag:nginx", "web-1,web-2"},
+		{"tag:nginx,!tag:canary", "staging,web-2"},
+		{"!production", "staging"},
+		{"&tag:postgres", "db-1,staging"},
+	}
+	for _, tc := range tcs {
+		got, err := spec.SelectMachines(tc.limit)
+		if err != nil {
+			t.Errorf("%q: %v", tc.limit, err)
+			continue
+		}
+		if strings.Join(got, ",") != tc.want {
+			t.Errorf("%q: got %v, want %s", tc.limit, got, tc.want)
+		}
+	}
+}
+
+func TestSelectMachinesErrorCases(t *testing.T) {
+	spec, err := ParseTargetSpecFile("testdata/targets/groups.hcl")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tcs := []struct {
+		limit string
+		err   string
+	}{
+		{"web-3", "limit matches no machines: web-3"},
+		{"tag:nope", "limit matches no machines: tag:nope"},
+		{"web,", "invalid limit: web,"},
+		{"[", "invalid limit pattern: ["},
+		{"web,&db", "limit selects no machines: web,&db"},
+	}
+	for _, tc := range tcs {
+		_, err := spec.SelectMachines(tc.limit)
+		if err == nil || err.Error() != tc.err {
+			t.Errorf("%q: got %v, want %q", tc.limit, err, tc.err)
+		}
+	}
+}
+
+func TestLimit(t *testing.T) {
+	spec, err := ParseTargetSpecFile("testdata/targets/groups.hcl")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	limited, err := spec.Limit("db")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(limited.Machine) != 1 || limited.Machine["db-1"] == nil {
+		t.Errorf("Got %d machines, want only db-1", len(limited.Machine))
+	}
+	// the original spec should be unchanged
+	if len(spec.Machine) != 5 {
+		t.Errorf("Got %d machines in the original spec, want 5", len(spec.Machine))
+	}
+}
@@ -1,5 +1,7 @@
 package config
 
+import "strings"
+
 // Valid machine types
 const (
 	KindLocal string = "local"
@@ -12,12 +14,41 @@ const (
 	AuthKindPrompt   = "prompt"
 	AuthKindLocalKey = "user-key"
 	AuthKindKeyFile  = "key-file"
+	AuthKindAgent    = "agent"
 )
 
+// Valid privilege escalation methods
+const (
+	BecomeSudo = "sudo"
+	BecomeSu   = "su"
+	BecomeDoas = "doas"
+)
+
+// HostKeyTypes are the key types a host_key fingerprint can be prefixed with, as written in known_hosts files.
+var HostKeyTypes = []string{"ssh-ed25519", "ecdsa-sha2-nistp256", "ecdsa-sha2-nistp384", "ecdsa-sha2-nistp521", "ssh-rsa"}
+
+// SplitHostKey splits a host_key into its key type (empty if not given) and fingerprint.
+func SplitHostKey(hostKey string) (keyType, fingerprint string) {
+	fields := strings.Fields(hostKey)
+	if len(fields) == 2 {
+		return fields[0], fields[1]
+	}
+	return "", hostKey
+}
+
 // MachineSpec describes the high-level schema for target configuration.
 type MachineSpec struct {
-	Name    string
-	Machine map[string]*Machine
+	Name        string
+	Parallelism int                  // maximum number of machines asserted on at once, defaults to 1
+	Variables   map[string]*Variable `hcl:"variable"` // available to every machine
+	Groups      map[string]*Group    `hcl:"group"`
+	Machine     map[string]*Machine
+}
+
+// Group describes a named set of machines, which can be selected by name with --limit.
+type Group struct {
+	Machines []string // names of machines in the group
+	Groups   []string // names of other groups, whose machines are also in this group
 }
 
 //Machine describes the target schema for a specific machine.
@@ -26,6 +57,44 @@ type Machine struct {
 	Destination string //only valid for non local machines
 	Username    string //only needed for SSH
 	Auth        []MachineAuth
+	Tags        []string //arbitrary labels, which machines can be selected by with --limit
+
+	ForwardAgent bool `hcl:"forward_agent"` //forward the local ssh-agent (SSH_AUTH_SOCK) to the target
+
+	HostKey    string `hcl:"host_key"`    //SHA256:<base64> or MD5:<hex> fingerprint of the expected host key, optionally prefixed with its type
+	KnownHosts string `hcl:"known_hosts"` //known_hosts file to verify against if HostKey is not set
+
+	// Via lists the SSH servers the connection is tunneled through, in the order they are connected to.
+	// After parsing, references to other machines are resolved so every hop is inline.
+	Via []*JumpHost `hcl:"via"`
+
+	// Become, if set, runs every command & file operation on the machine as another user.
+	Become *Become
+
+	// Variables are available to interpolation in this machine, and in assertions run on it.
+	Variables map[string]*Variable `hcl:"variable"`
+	// Vars are user-defined variables, available to templates as .Vars and to interpolation as ${var.<name>}.
+	// After parsing, it holds every variable of the machine, including those from variable blocks.
+	Vars map[string]string `hcl:"vars"`
+}
+
+// Become describes how to escalate privileges on a machine.
+type Become struct {
+	Method   string //sudo (default), su or doas
+	User     string //defaults to root
+	Password string //only supported for sudo
+	Prompt   bool   //prompt for the password, only supported for sudo
+}
+
+// JumpHost describes an SSH server (bastion) which connections to a machine are tunneled through.
+// It either references another ssh machine in the spec by name, or describes the server inline.
+type JumpHost struct {
+	Machine     string //name of another machine in the spec
+	Destination string
+	Username    string
+	Auth        []MachineAuth
+	HostKey     string `hcl:"host_key"`
+	KnownHosts  string `hcl:"known_hosts"`
 }
 
 //MachineAuth describes the scheme for machine authentication configuration.
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/davecgh/go-spew/spew"
@@ -106,3 +108,191 @@ func TestBasicTargetsParseErrorCases(t *testing.T) {
 		t.Error("nil spec expected")
 	}
 }
+
+func TestParallelismTargetsParse(t *testing.T) {
+	spec, err := ParseTargetSpecFile("testdata/targets/parallel.hcl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if spec.Parallelism != 4 {
+		t.Errorf("Got spec.Parallelism=%d, wanted 4", spec.Parallelism)
+	}
+
+	_, err = ParseTargetSpecFile("testdata/targets/invalid_parallelism.hcl")
+	if err == nil || err.Error() != "parallelism cannot be negative" {
+		t.Errorf("Got %v, want 'parallelism cannot be negative'", err)
+	}
+}
+
+func TestHostKeyTargetsParse(t *testing.T) {
+	spec, err := ParseTargetSpecFile("testdata/targets/hostkey.hcl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hk := spec.Machine["frontend-1"].HostKey; hk != "SHA256:BTF0wVTRmHHD93cm8UBtsicJI1+bsM3QcCOQfiuD1tQ" {
+		t.Errorf("Got host_key=%q", hk)
+	}
+	if kh := spec.Machine["frontend-2"].KnownHosts; kh != "~/.ssh/frontend_known_hosts" {
+		t.Errorf("Got known_hosts=%q", kh)
+	}
+	keyType, fingerprint := SplitHostKey(spec.Machine["frontend-3"].HostKey)
+	if keyType != "ssh-ed25519" || fingerprint != "SHA256:BTF0wVTRmHHD93cm8UBtsicJI1+bsM3QcCOQfiuD1tQ" {
+		t.Errorf("Got host_key type=%q fingerprint=%q", keyType, fingerprint)
+	}
+
+	_, err = ParseTargetSpecFile("testdata/targets/invalid_hostkey.hcl")
+	if err == nil || err.Error() != "host_key must be a SHA256: or MD5: fingerprint" {
+		t.Errorf("Got %v, want 'host_key must be a SHA256: or MD5: fingerprint'", err)
+	}
+	_, err = ParseTargetSpecFile("testdata/targets/invalid_hostkey_type.hcl")
+	if err == nil || err.Error() != "unsupported host_key type: ssh-dss" {
+		t.Errorf("Got %v, want 'unsupported host_key type: ssh-dss'", err)
+	}
+}
+
+func TestSSHAgentTargetsParse(t *testing.T) {
+	spec, err := ParseTargetSpecFile("testdata/targets/sshagent.hcl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m1 := spec.Machine["frontend-1"]
+	if len(m1.Auth) != 1 || m1.Auth[0].Kind != AuthKindAgent || !m1.ForwardAgent {
+		t.Error("Incorrect auth data, got: ", spew.Sdump(m1))
+	}
+
+	_, err = ParseTargetSpecFile("testdata/targets/invalid_forward_agent.hcl")
+	if err == nil || err.Error() != "forward_agent is only valid for ssh machines" {
+		t.Errorf("Got %v, want 'forward_agent is only valid for ssh machines'", err)
+	}
+}
+
+func TestJumpHostTargetsParse(t *testing.T) {
+	spec, err := ParseTargetSpecFile("testdata/targets/jumphosts.hcl")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db1 := spec.Machine["db-1"]
+	if len(db1.Via) != 2 {
+		t.Fatalf("Got %d hops for db-1, wanted 2: %s", len(db1.Via), spew.Sdump(db1.Via))
+	}
+	if db1.Via[0].Machine != "outer-bastion" || db1.Via[0].Destination != "bastion.example.com" || db1.Via[0].Username != "jump" {
+		t.Errorf("Incorrect first hop, got: %s", spew.Sdump(db1.Via[0]))
+	}
+	if db1.Via[1].Machine != "inner-bastion" || db1.Via[1].Destination != "10.1.0.1" || len(db1.Via[1].Auth) != 1 {
+		t.Errorf("Incorrect second hop, got: %s", spew.Sdump(db1.Via[1]))
+	}
+
+	db2 := spec.Machine["db-2"]
+	if len(db2.Via) != 1 || db2.Via[0].Destination != "bastion.example.com:2222" {
+		t.Fatalf("Incorrect hops for db-2, got: %s", spew.Sdump(db2.Via))
+	}
+	// normalization should apply to the auth blocks of inline hops too
+	if len(db2.Via[0].Auth) != 1 || db2.Via[0].Auth[0].Kind != AuthKindPassword {
+		t.Errorf("Incorrect hop auth data, got: %s", spew.Sdump(db2.Via[0].Auth))
+	}
+}
+
+func TestJumpHostTargetsParseErrorCases(t *testing.T) {
+	_, err := ParseTargetSpecFile("testdata/targets/invalid_via_cycle.hcl")
+	if err == nil || !strings.HasPrefix(err.Error(), "via references form a cycle at machine: ") {
+		t.Errorf("Got %v, want cycle error", err)
+	}
+
+	_, err = ParseTargetSpecFile("testdata/targets/invalid_via_unknown.hcl")
+	if err == nil || err.Error() != "via references unknown machine: nope" {
+		t.Errorf("Got %v, want 'via references unknown machine: nope'", err)
+	}
+}
+
+func TestBecomeTargetsParse(t *testing.T) {
+	spec, err := ParseTargetSpecFile("testdata/targets/become.hcl")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := spec.Machine["frontend-1"].Become
+	if b == nil || b.Method != BecomeSudo || b.User != "deploy" || !b.Prompt {
+		t.Error("Incorrect become data, got: ", spew.Sdump(b))
+	}
+	// user should be defaulted to root
+	b = spec.Machine["local"].Become
+	if b == nil || b.Method != BecomeDoas || b.User != "root" {
+		t.Error("Incorrect become data, got: ", spew.Sdump(b))
+	}
+
+	_, err = ParseTargetSpecFile("testdata/targets/invalid_become.hcl")
+	if err == nil || err.Error() != "password/prompt is only supported for the sudo become method" {
+		t.Errorf("Got %v, want 'password/prompt is only supported for the sudo become method'", err)
+	}
+}
+
+func TestVarsTargetsParse(t *testing.T) {
+	spec, err := ParseTargetSpecFile("testdata/targets/vars.hcl")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vars := spec.Machine["frontend-1"].Vars
+	if len(vars) != 2 || vars["listen_port"] != "8080" || vars["environment"] != "production" {
+		t.Error("Incorrect vars, got: ", spew.Sdump(vars))
+	}
+}
+
+func TestVariablesTargetsParse(t *testing.T) {
+	os.Setenv("MACHASSERT_TEST_USER", "deploy")
+	defer os.Unsetenv("MACHASSERT_TEST_USER")
+
+	spec, err := ParseTargetSpecFile("testdata/targets/variables.hcl")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m1 := spec.Machine["web-1"]
+	// the machine variable block should override the spec-level variable
+	if m1.Destination != "web-1.internal.example.com" || m1.Username != "deploy" {
+		t.Error("Incorrect data, got: ", spew.Sdump(m1))
+	}
+	if m1.Vars["prefix"] != "/opt/web-1" || m1.Vars["domain"] != "internal.example.com" || m1.Vars["deploy_user"] != "deploy" {
+		t.Error("Incorrect vars, got: ", spew.Sdump(m1.Vars))
+	}
+	// $${ escapes interpolation
+	m2 := spec.Machine["web-2"]
+	if m2.Destination != "web-2.example.com" || m2.Username != "${literal}" {
+		t.Error("Incorrect data, got: ", spew.Sdump(m2))
+	}
+
+	_, err = ParseTargetSpecFile("testdata/targets/invalid_variable.hcl")
+	if err == nil || err.Error() != "machine web-1: undefined variable: domain" {
+		t.Errorf("Got %v, want 'machine web-1: undefined variable: domain'", err)
+	}
+}
+
+func TestGroupsTargetsParse(t *testing.T) {
+	spec, err := ParseTargetSpecFile("testdata/targets/groups.hcl")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if tags := spec.Machine["web-1"].Tags; len(tags) != 2 || tags[0] != "nginx" || tags[1] != "canary" {
+		t.Errorf("Got tags=%v, want [nginx canary]", tags)
+	}
+	if got := strings.Join(spec.GroupMachines("production"), ","); got != "db-1,web-1,web-2,worker-1" {
+		t.Errorf("Got production=%s, want db-1,web-1,web-2,worker-1", got)
+	}
+	if got := strings.Join(spec.MachineGroups("web-2"), ","); got != "production,web" {
+		t.Errorf("Got groups of web-2=%s, want production,web", got)
+	}
+}
+
+func TestGroupsTargetsParseErrorCases(t *testing.T) {
+	_, err := ParseTargetSpecFile("testdata/targets/invalid_group_unknown.hcl")
+	if err == nil || err.Error() != "group web references unknown machine: web-3" {
+		t.Errorf("Got %v, want 'group web references unknown machine: web-3'", err)
+	}
+
+	_, err = ParseTargetSpecFile("testdata/targets/invalid_group_cycle.hcl")
+	if err == nil || !strings.HasPrefix(err.Error(), "group references form a cycle at group: ") {
+		t.Errorf("Got %v, want cycle error", err)
+	}
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"errors"
 	"io/ioutil"
+	"strings"
 
 	"github.com/hashicorp/hcl"
 )
@@ -20,20 +21,54 @@ func ParseTargetSchema(data []byte) (*MachineSpec, error) {
 		return nil, err
 	}
 
-	err = normalizeMachineSpec(&outSpec)
+	return finishMachineSpec(&outSpec)
+}
+
+// finishMachineSpec normalizes, interpolates and validates a decoded spec, and resolves its jump hosts.
+func finishMachineSpec(spec *MachineSpec) (*MachineSpec, error) {
+	err := normalizeMachineSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+	err = resolveVariables(spec)
+	if err != nil {
+		return nil, err
+	}
+	err = validateMachineSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+	err = validateGroups(spec)
 	if err != nil {
 		return nil, err
 	}
-	err = validateMachineSpec(&outSpec)
+	err = resolveJumpHosts(spec)
 	if err != nil {
 		return nil, err
 	}
 
-	return &outSpec, nil
+	return spec, nil
 }
 
-// ParseTargetSpecFile parses the targets file from disk.
+// ExecInventoryPrefix marks a targets path as an executable, which is run with its output parsed as a JSON
+// inventory. Executables must be marked explicitly, so a targets file is never run by accident.
+const ExecInventoryPrefix = "exec:"
+
+// ParseTargetSpecFile parses the targets file from disk. Files ending in .json are parsed as JSON
+// inventories (see ParseInventory), and paths starting with ExecInventoryPrefix are run, with their
+// output parsed as a JSON inventory. Anything else is parsed as HCL.
 func ParseTargetSpecFile(fpath string) (*MachineSpec, error) {
+	if strings.HasPrefix(fpath, ExecInventoryPrefix) {
+		return runInventory(strings.TrimPrefix(fpath, ExecInventoryPrefix))
+	}
+	if strings.HasSuffix(fpath, ".json") {
+		d, err := ioutil.ReadFile(fpath)
+		if err != nil {
+			return nil, err
+		}
+		return ParseInventory(d)
+	}
+
 	d, err := ioutil.ReadFile(fpath)
 	if err != nil {
 		return nil, err
@@ -49,16 +84,36 @@ func normalizeMachineSpec(spec *MachineSpec) error {
 			spec.Machine[k].Kind = KindLocal
 		}
 
-		for i := range spec.Machine[k].Auth {
-			if spec.Machine[k].Auth[i].Password != "" { //If password is set, set the auth kind to password
-				spec.Machine[k].Auth[i].Kind = AuthKindPassword
+		if b := spec.Machine[k].Become; b != nil {
+			if b.Method == "" {
+				b.Method = BecomeSudo
+			}
+			if b.User == "" {
+				b.User = "root"
 			}
 		}
+
+		normalizeAuth(spec.Machine[k].Auth)
+		for _, hop := range spec.Machine[k].Via {
+			normalizeAuth(hop.Auth)
+		}
 	}
 	return nil
 }
 
+func normalizeAuth(auth []MachineAuth) {
+	for i := range auth {
+		if auth[i].Password != "" { //If password is set, set the auth kind to password
+			auth[i].Kind = AuthKindPassword
+		}
+	}
+}
+
 func validateMachineSpec(spec *MachineSpec) error {
+	if spec.Parallelism < 0 {
+		return errors.New("parallelism cannot be negative")
+	}
+
 	for k := range spec.Machine {
 
 		switch spec.Machine[k].Kind {
@@ -68,21 +123,163 @@ func validateMachineSpec(spec *MachineSpec) error {
 			return errors.New("")
 		}
 
-		for i := range spec.Machine[k].Auth {
-			switch spec.Machine[k].Auth[i].Kind {
-			case AuthKindLocalKey:
-			case AuthKindPrompt:
-			case AuthKindKeyFile:
-				if spec.Machine[k].Auth[i].Key == "" {
-					return errors.New("key file must be specified for keyfile authentication")
-				}
-			case AuthKindPassword:
-				if spec.Machine[k].Auth[i].Password == "" {
-					return errors.New("password must be specified for password authentication")
-				}
-			default:
-				return errors.New("Invalid machine auth type")
+		if spec.Machine[k].ForwardAgent && spec.Machine[k].Kind != KindSSH {
+			return errors.New("forward_agent is only valid for ssh machines")
+		}
+
+		if err := validateHostKey(spec.Machine[k].HostKey); err != nil {
+			return err
+		}
+		if err := validateAuth(spec.Machine[k].Auth); err != nil {
+			return err
+		}
+
+		if err := validateBecome(spec.Machine[k].Become); err != nil {
+			return err
+		}
+
+		if len(spec.Machine[k].Via) > 0 && spec.Machine[k].Kind != KindSSH {
+			return errors.New("via is only valid for ssh machines")
+		}
+		for _, hop := range spec.Machine[k].Via {
+			if err := validateJumpHost(spec, hop); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func validateBecome(b *Become) error {
+	if b == nil {
+		return nil
+	}
+	switch b.Method {
+	case BecomeSudo:
+		if b.Password != "" && b.Prompt {
+			return errors.New("only one of password/prompt may be specified for become")
+		}
+	case BecomeSu, BecomeDoas:
+		if b.Password != "" || b.Prompt {
+			return errors.New("password/prompt is only supported for the sudo become method")
+		}
+	default:
+		return errors.New("unsupported become method: " + b.Method)
+	}
+	return nil
+}
+
+func validateHostKey(hk string) error {
+	if hk == "" {
+		return nil
+	}
+	keyType, fingerprint := SplitHostKey(hk)
+	if !strings.HasPrefix(fingerprint, "SHA256:") && !strings.HasPrefix(fingerprint, "MD5:") {
+		return errors.New("host_key must be a SHA256: or MD5: fingerprint")
+	}
+	if keyType != "" {
+		for _, t := range HostKeyTypes {
+			if keyType == t {
+				return nil
+			}
+		}
+		return errors.New("unsupported host_key type: " + keyType)
+	}
+	return nil
+}
+
+func validateAuth(auth []MachineAuth) error {
+	for i := range auth {
+		switch auth[i].Kind {
+		case AuthKindLocalKey:
+		case AuthKindPrompt:
+		case AuthKindAgent:
+		case AuthKindKeyFile:
+			if auth[i].Key == "" {
+				return errors.New("key file must be specified for keyfile authentication")
+			}
+		case AuthKindPassword:
+			if auth[i].Password == "" {
+				return errors.New("password must be specified for password authentication")
+			}
+		default:
+			return errors.New("Invalid machine auth type")
+		}
+	}
+	return nil
+}
+
+func validateJumpHost(spec *MachineSpec, hop *JumpHost) error {
+	if hop.Machine != "" {
+		if hop.Destination != "" || hop.Username != "" || len(hop.Auth) > 0 {
+			return errors.New("via blocks referencing a machine cannot also specify destination/username/auth")
+		}
+		ref, ok := spec.Machine[hop.Machine]
+		if !ok {
+			return errors.New("via references unknown machine: " + hop.Machine)
+		}
+		if ref.Kind != KindSSH {
+			return errors.New("via must reference an ssh machine: " + hop.Machine)
+		}
+		return nil
+	}
+
+	if hop.Destination == "" {
+		return errors.New("destination or machine must be specified for via blocks")
+	}
+	if err := validateHostKey(hop.HostKey); err != nil {
+		return err
+	}
+	return validateAuth(hop.Auth)
+}
+
+// resolveJumpHosts replaces via blocks which reference other machines with the hops needed to
+// reach that machine, followed by the machine itself. Cycles are reported as errors.
+func resolveJumpHosts(spec *MachineSpec) error {
+	resolved := map[string]bool{}
+	visiting := map[string]bool{}
+
+	var resolve func(name string) error
+	resolve = func(name string) error {
+		if resolved[name] {
+			return nil
+		}
+		if visiting[name] {
+			return errors.New("via references form a cycle at machine: " + name)
+		}
+		visiting[name] = true
+
+		m := spec.Machine[name]
+		var hops []*JumpHost
+		for _, hop := range m.Via {
+			if hop.Machine == "" {
+				hops = append(hops, hop)
+				continue
 			}
+			if err := resolve(hop.Machine); err != nil {
+				return err
+			}
+			ref := spec.Machine[hop.Machine]
+			hops = append(hops, ref.Via...)
+			hops = append(hops, &JumpHost{
+				Machine:     hop.Machine,
+				Destination: ref.Destination,
+				Username:    ref.Username,
+				Auth:        ref.Auth,
+				HostKey:     ref.HostKey,
+				KnownHosts:  ref.KnownHosts,
+			})
+		}
+		m.Via = hops
+
+		visiting[name] = false
+		resolved[name] = true
+		return nil
+	}
+
+	for name := range spec.Machine {
+		if err := resolve(name); err != nil {
+			return err
 		}
 	}
 	return nil
@@ -0,0 +1,9 @@
+name = "bad chown action"
+
+assert "data dir" {
+  kind = "exists"
+  file_path = "/srv/data"
+  or {
+    action = "CHOWN"
+  }
+}
@@ -0,0 +1,12 @@
+name = "test"
+
+assert "bad copy mode" {
+  kind = "exists"
+  file_path = "~/dev.hcl"
+  or "apply files" {
+    action = "COPY"
+    source_path = "dev.hcl"
+    destination_path = "~/dev.hcl"
+    mode = "0999"
+  }
+}
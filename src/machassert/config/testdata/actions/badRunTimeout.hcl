@@ -0,0 +1,11 @@
+name = "run"
+
+assert "app built" {
+  kind = "exists"
+  file_path = "/opt/app/bin/app"
+  or "build" {
+    action = "RUN"
+    command = "make install"
+    timeout = "5 minutes"
+  }
+}
@@ -0,0 +1,10 @@
+name = "test"
+
+assert "nginx running" {
+  kind = "service_running"
+  service = "nginx"
+  or "bounce" {
+    action = "SERVICE"
+    operation = "bounce"
+  }
+}
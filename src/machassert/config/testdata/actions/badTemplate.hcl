@@ -0,0 +1,9 @@
+name = "bad template action"
+
+assert "nginx config" {
+  kind = "exists"
+  file_path = "/etc/nginx/conf.d/app.conf"
+  or {
+    action = "TEMPLATE"
+  }
+}
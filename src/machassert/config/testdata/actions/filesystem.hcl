@@ -0,0 +1,39 @@
+name = "filesystem"
+
+assert "data dir" {
+  kind = "file_attributes"
+  file_path = "/srv/data"
+  file_type = "directory"
+  mode = "0750"
+  owner = "app"
+  group = "app"
+  or "create" {
+    action = "MKDIR"
+    parents = true
+  }
+  or "permissions" {
+    action = "CHMOD"
+  }
+  or "ownership" {
+    action = "CHOWN"
+  }
+}
+
+assert "current release" {
+  kind = "file_attributes"
+  file_path = "/srv/current"
+  file_type = "symlink"
+  link_target = "/srv/releases/2"
+  or "link" {
+    action = "SYMLINK"
+  }
+}
+
+assert "no stale cache" {
+  kind = "!exists"
+  file_path = "/srv/cache"
+  or "cleanup" {
+    action = "DELETE"
+    recursive = true
+  }
+}
@@ -0,0 +1,20 @@
+name = "run"
+
+assert "app built" {
+  kind = "exists"
+  file_path = "/opt/app/bin/app"
+  or "build" {
+    action = "RUN"
+    command = "make install"
+    dir = "/opt/app/src"
+    timeout = "5m"
+    exit_codes = [0, 2]
+    env {
+      PREFIX = "/opt/app"
+    }
+  }
+  or "check" {
+    action = "RUN"
+    command = "/opt/app/bin/app --version"
+  }
+}
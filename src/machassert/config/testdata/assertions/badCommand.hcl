@@ -0,0 +1,7 @@
+name = "bad command assertion"
+
+assert "kernel version" {
+  kind = "command"
+  command = "uname -r"
+  stdout_regex = "^4\\.9\\.("
+}
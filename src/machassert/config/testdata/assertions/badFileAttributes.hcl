@@ -0,0 +1,8 @@
+name = "bad file attributes assertion"
+
+assert "localtime" {
+  kind = "file_attributes"
+  file_path = "/etc/localtime"
+  file_type = "file"
+  link_target = "/usr/share/zoneinfo/UTC"
+}
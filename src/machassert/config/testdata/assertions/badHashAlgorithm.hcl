@@ -0,0 +1,8 @@
+name = "bad hash algorithm"
+
+assert "motd" {
+  kind = "md5_match"
+  file_path = "/etc/motd"
+  hash = "d41d8cd98f00b204e9800998ecf8427e"
+  hash_algorithm = "crc32"
+}
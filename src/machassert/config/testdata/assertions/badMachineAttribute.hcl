@@ -0,0 +1,7 @@
+name = "variables"
+
+assert "hostname" {
+  kind = "command"
+  command = "hostname"
+  stdout = "${machine.hostname}"
+}
@@ -0,0 +1,7 @@
+name = "bad on_failure"
+
+assert "keep going" {
+  kind = "exists"
+  file_path = "/opt/app"
+  on_failure = "ignore"
+}
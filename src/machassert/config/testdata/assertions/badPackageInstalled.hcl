@@ -0,0 +1,6 @@
+name = "bad package installed assertion"
+
+assert "check nginx" {
+  kind = "package_installed"
+  version = ">= 1.10"
+}
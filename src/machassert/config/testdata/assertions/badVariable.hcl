@@ -0,0 +1,15 @@
+name = "variables"
+
+variable "prefix" {
+  default = "/usr/local"
+}
+
+assert "app binary" {
+  kind = "exists"
+  file_path = "${var.prefix}/bin/app"
+}
+
+assert "app config" {
+  kind = "exists"
+  file_path = "${var.config_dir}/app.conf"
+}
@@ -0,0 +1,15 @@
+name = "command"
+
+assert "kernel version" {
+  kind = "command"
+  command = "uname -r"
+  stdout_regex = "^4\\.9\\."
+}
+
+assert "java version" {
+  kind = "command"
+  command = "java -version"
+  exit_codes = [0, 1]
+  stderr_regex = "version \"1\\.8"
+  timeout = "10s"
+}
@@ -0,0 +1,24 @@
+name = "deps"
+
+assert "package" {
+  kind = "package_installed"
+  package = "nginx"
+}
+
+assert "config" {
+  kind = "exists"
+  file_path = "/etc/nginx/nginx.conf"
+  depends_on = ["package"]
+}
+
+assert "running" {
+  kind = "service_running"
+  service = "nginx"
+  depends_on = ["deps.config", "other.firewall"]
+}
+
+assert "kernel" {
+  kind = "command"
+  command = "uname"
+  depends_on = []
+}
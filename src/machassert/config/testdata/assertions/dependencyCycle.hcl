@@ -0,0 +1,19 @@
+name = "cycle"
+
+assert "a" {
+  kind = "exists"
+  file_path = "/a"
+  depends_on = ["c"]
+}
+
+assert "b" {
+  kind = "exists"
+  file_path = "/b"
+  depends_on = ["a"]
+}
+
+assert "c" {
+  kind = "exists"
+  file_path = "/c"
+  depends_on = ["b"]
+}
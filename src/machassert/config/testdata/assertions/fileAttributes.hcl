@@ -0,0 +1,19 @@
+name = "file attributes"
+
+assert "sshd config" {
+  kind = "file_attributes"
+  file_path = "/etc/ssh/sshd_config"
+  mode = "0600"
+  owner = "root"
+  group = "0"
+  file_type = "file"
+  min_size = 1
+  max_size = 65536
+}
+
+assert "localtime" {
+  kind = "file_attributes"
+  file_path = "/etc/localtime"
+  file_type = "symlink"
+  link_target = "/usr/share/zoneinfo/UTC"
+}
@@ -0,0 +1,15 @@
+name = "hash algorithms"
+
+assert "motd" {
+  kind = "md5_match"
+  file_path = "/etc/motd"
+  hash = "a8f3c2ad46e5fd8d7e5a4d6b1c56b3e6c2e9a3d6a1d0f3a5c7e6b2d4f8a9c0b1"
+  hash_algorithm = "sha256"
+}
+
+assert "sshd config" {
+  kind = "file_match"
+  file_path = "/etc/ssh/sshd_config"
+  base_path = "configs/sshd_config"
+  hash_algorithm = "blake2b"
+}
@@ -0,0 +1,18 @@
+name = "on failure"
+
+assert "optional" {
+  kind = "exists"
+  file_path = "/opt/optional"
+  ignore_errors = true
+}
+
+assert "keep going" {
+  kind = "exists"
+  file_path = "/opt/app"
+  on_failure = "continue"
+}
+
+assert "default" {
+  kind = "exists"
+  file_path = "/opt/other"
+}
@@ -0,0 +1,10 @@
+name = "packages"
+
+assert "nginx installed" {
+  kind = "package_installed"
+  package = "nginx"
+  version = ">= 1.10"
+  or "install nginx" {
+    action = "INSTALL_PACKAGE"
+  }
+}
@@ -0,0 +1,19 @@
+name = "services"
+
+assert "nginx running" {
+  kind = "service_running"
+  service = "nginx.service"
+  or "start nginx" {
+    action = "SERVICE"
+    operation = "start"
+  }
+}
+
+assert "nginx enabled" {
+  kind = "service_enabled"
+  service = "nginx.service"
+  or "enable nginx" {
+    action = "SERVICE"
+    operation = "enable"
+  }
+}
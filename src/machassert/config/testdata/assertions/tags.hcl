@@ -0,0 +1,18 @@
+name = "tagged"
+
+assert "nginx installed" {
+  kind = "package_installed"
+  package = "nginx"
+  tags = ["nginx", "packages"]
+}
+
+assert "nginx config" {
+  kind = "exists"
+  file_path = "/etc/nginx/nginx.conf"
+  tags = ["nginx"]
+}
+
+assert "motd" {
+  kind = "exists"
+  file_path = "/etc/motd"
+}
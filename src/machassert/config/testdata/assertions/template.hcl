@@ -0,0 +1,11 @@
+name = "template"
+
+assert "nginx config" {
+  kind = "template_match"
+  file_path = "/etc/nginx/conf.d/app.conf"
+  template = "templates/app.conf.tmpl"
+  or "render" {
+    action = "TEMPLATE"
+    mode = "0644"
+  }
+}
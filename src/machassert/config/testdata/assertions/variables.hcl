@@ -0,0 +1,23 @@
+name = "variables"
+
+variable "prefix" {
+  default = "/usr/local"
+}
+
+variable "mode" {
+  default = "0755"
+}
+
+assert "app binary" {
+  kind = "file_attributes"
+  file_path = "${var.prefix}/bin/app"
+  mode = "${var.mode}"
+  or "install" {
+    action = "RUN"
+    command = "make install PREFIX=${var.prefix} HOST=${machine.name}"
+  }
+  or "link" {
+    action = "RUN"
+    command = "ln -sf ${var.prefix}/bin/app ${HOME}/bin/app"
+  }
+}
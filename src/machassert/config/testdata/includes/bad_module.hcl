@@ -0,0 +1,6 @@
+module "ssh" {
+  source = "lib/hardening.hcl"
+  args {
+    config_pth = "/etc/ssh/sshd_config"
+  }
+}
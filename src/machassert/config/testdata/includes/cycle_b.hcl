@@ -0,0 +1 @@
+include = ["cycle_a.hcl"]
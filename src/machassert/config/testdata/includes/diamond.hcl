@@ -0,0 +1,3 @@
+name = "diamond"
+
+include = ["lib/web.hcl", "lib/db.hcl"]
@@ -0,0 +1,13 @@
+variable "prefix" {
+  default = "/usr/local"
+}
+
+variable "admin" {
+  default = "root"
+}
+
+assert "motd" {
+  kind = "exists"
+  file_path = "/etc/motd"
+  order = 1
+}
@@ -0,0 +1,7 @@
+include = ["common.hcl"]
+
+assert "postgres" {
+  kind = "package_installed"
+  package = "postgresql"
+  depends_on = ["motd"]
+}
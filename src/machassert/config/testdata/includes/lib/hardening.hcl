@@ -0,0 +1,25 @@
+variable "config_path" {}
+
+variable "owner" {
+  default = "root"
+}
+
+variable "mode" {
+  default = "0600"
+}
+
+assert "config exists" {
+  kind = "exists"
+  file_path = "${var.config_path}"
+}
+
+assert "config permissions" {
+  kind = "file_attributes"
+  depends_on = ["config exists"]
+  file_path = "${var.config_path}"
+  owner = "${var.owner}"
+  mode = "${var.mode}"
+  or "fix" {
+    action = "CHMOD"
+  }
+}
@@ -0,0 +1,6 @@
+include = ["common.hcl"]
+
+assert "nginx" {
+  kind = "package_installed"
+  package = "nginx"
+}
@@ -0,0 +1,20 @@
+name = "web"
+
+include = ["lib/common.hcl"]
+
+variable "prefix" {
+  default = "/opt/web"
+}
+
+module "ssh" {
+  source = "lib/hardening.hcl"
+  args {
+    config_path = "/etc/ssh/sshd_config"
+    owner = "${var.admin}"
+  }
+}
+
+assert "app" {
+  kind = "exists"
+  file_path = "${var.prefix}/bin/app"
+}
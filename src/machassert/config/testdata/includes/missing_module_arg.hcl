@@ -0,0 +1,6 @@
+module "ssh" {
+  source = "lib/hardening.hcl"
+  args {
+    owner = "admin"
+  }
+}
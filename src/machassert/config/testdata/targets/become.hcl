@@ -0,0 +1,18 @@
+machine "frontend-1" {
+  kind = "ssh"
+  destination = "10.5.32.1"
+  auth {
+      kind = "agent"
+  }
+  become {
+      user = "deploy"
+      prompt = true
+  }
+}
+
+machine "local" {
+  kind = "local"
+  become {
+      method = "doas"
+  }
+}
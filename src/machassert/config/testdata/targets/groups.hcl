@@ -0,0 +1,52 @@
+group "web" {
+  machines = ["web-1", "web-2"]
+}
+
+group "db" {
+  machines = ["db-1"]
+}
+
+group "production" {
+  machines = ["worker-1"]
+  groups = ["web", "db"]
+}
+
+machine "web-1" {
+  kind = "ssh"
+  destination = "10.0.1.1"
+  tags = ["nginx", "canary"]
+  auth {
+      kind = "agent"
+  }
+}
+
+machine "web-2" {
+  kind = "ssh"
+  destination = "10.0.1.2"
+  tags = ["nginx"]
+  auth {
+      kind = "agent"
+  }
+}
+
+machine "db-1" {
+  kind = "ssh"
+  destination = "10.0.2.1"
+  tags = ["postgres"]
+  auth {
+      kind = "agent"
+  }
+}
+
+machine "worker-1" {
+  kind = "ssh"
+  destination = "10.0.3.1"
+  auth {
+      kind = "agent"
+  }
+}
+
+machine "staging" {
+  kind = "local"
+  tags = ["nginx", "postgres"]
+}
@@ -0,0 +1,26 @@
+machine "frontend-1" {
+  kind = "ssh"
+  destination = "10.5.32.1"
+  host_key = "SHA256:BTF0wVTRmHHD93cm8UBtsicJI1+bsM3QcCOQfiuD1tQ"
+  auth {
+      password = "1234"
+  }
+}
+
+machine "frontend-2" {
+  kind = "ssh"
+  destination = "10.5.32.2"
+  known_hosts = "~/.ssh/frontend_known_hosts"
+  auth {
+      password = "1234"
+  }
+}
+
+machine "frontend-3" {
+  kind = "ssh"
+  destination = "10.5.32.3"
+  host_key = "ssh-ed25519 SHA256:BTF0wVTRmHHD93cm8UBtsicJI1+bsM3QcCOQfiuD1tQ"
+  auth {
+      password = "1234"
+  }
+}
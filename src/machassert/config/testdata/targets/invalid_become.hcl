@@ -0,0 +1,7 @@
+machine "local" {
+  kind = "local"
+  become {
+      method = "su"
+      password = "hunter2"
+  }
+}
@@ -0,0 +1,4 @@
+machine "local-1" {
+  kind = "local"
+  forward_agent = true
+}
@@ -0,0 +1,11 @@
+group "a" {
+  groups = ["b"]
+}
+
+group "b" {
+  groups = ["a"]
+}
+
+machine "web-1" {
+  kind = "local"
+}
@@ -0,0 +1,7 @@
+group "web" {
+  machines = ["web-1", "web-3"]
+}
+
+machine "web-1" {
+  kind = "local"
+}
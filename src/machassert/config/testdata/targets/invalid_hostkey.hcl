@@ -0,0 +1,5 @@
+machine "frontend-1" {
+  kind = "ssh"
+  destination = "10.5.32.1"
+  host_key = "BTF0wVTRmHHD93cm8UBtsicJI1+bsM3QcCOQfiuD1tQ"
+}
@@ -0,0 +1,3 @@
+#!/bin/sh
+# Fails, as a script would if the asset database was unavailable.
+exit 3
@@ -0,0 +1,5 @@
+parallelism = -1
+
+machine "frontend-1" {
+  kind = "local"
+}
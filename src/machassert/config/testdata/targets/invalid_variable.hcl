@@ -0,0 +1,7 @@
+machine "web-1" {
+  kind = "ssh"
+  destination = "${var.domain}"
+  auth {
+      kind = "agent"
+  }
+}
@@ -0,0 +1,15 @@
+machine "a" {
+  kind = "ssh"
+  destination = "10.0.0.1"
+  via {
+      machine = "b"
+  }
+}
+
+machine "b" {
+  kind = "ssh"
+  destination = "10.0.0.2"
+  via {
+      machine = "a"
+  }
+}
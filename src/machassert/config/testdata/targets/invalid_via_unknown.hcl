@@ -0,0 +1,7 @@
+machine "a" {
+  kind = "ssh"
+  destination = "10.0.0.1"
+  via {
+      machine = "nope"
+  }
+}
@@ -0,0 +1,14 @@
+#!/bin/sh
+# Outputs a JSON inventory, as a script querying an asset database would.
+cat <<'JSON'
+{
+  "machines": {
+    "db-1": {
+      "kind": "ssh",
+      "destination": "10.0.2.1",
+      "auth": [{"kind": "agent"}],
+      "tags": ["postgres"]
+    }
+  }
+}
+JSON
@@ -0,0 +1,46 @@
+machine "outer-bastion" {
+  kind = "ssh"
+  destination = "bastion.example.com"
+  username = "jump"
+  auth {
+      kind = "agent"
+  }
+}
+
+machine "inner-bastion" {
+  kind = "ssh"
+  destination = "10.1.0.1"
+  username = "jump"
+  via "outer" {
+      machine = "outer-bastion"
+  }
+  auth {
+      kind = "agent"
+  }
+}
+
+machine "db-1" {
+  kind = "ssh"
+  destination = "10.2.0.5"
+  via "inner" {
+      machine = "inner-bastion"
+  }
+  auth {
+      password = "1234"
+  }
+}
+
+machine "db-2" {
+  kind = "ssh"
+  destination = "10.2.0.6"
+  via "bastion" {
+      destination = "bastion.example.com:2222"
+      username = "jump"
+      auth {
+          password = "hunter2"
+      }
+  }
+  auth {
+      kind = "agent"
+  }
+}
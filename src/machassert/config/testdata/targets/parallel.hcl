@@ -0,0 +1,6 @@
+name = "Frontend servers"
+parallelism = 4
+
+machine "frontend-1" {
+  kind = "local"
+}
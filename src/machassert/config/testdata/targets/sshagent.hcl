@@ -0,0 +1,8 @@
+machine "frontend-1" {
+  kind = "ssh"
+  destination = "10.5.32.1"
+  forward_agent = true
+  auth {
+      kind = "agent"
+  }
+}
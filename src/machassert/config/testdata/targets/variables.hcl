@@ -0,0 +1,31 @@
+variable "domain" {
+  default = "example.com"
+}
+
+variable "deploy_user" {
+  default = "${env.MACHASSERT_TEST_USER}"
+}
+
+machine "web-1" {
+  kind = "ssh"
+  destination = "${machine.name}.${var.domain}"
+  username = "${var.deploy_user}"
+  auth {
+      kind = "agent"
+  }
+  variable "domain" {
+      default = "internal.example.com"
+  }
+  vars {
+      prefix = "/opt/${machine.name}"
+  }
+}
+
+machine "web-2" {
+  kind = "ssh"
+  destination = "${machine.name}.${var.domain}"
+  username = "$${literal}"
+  auth {
+      kind = "agent"
+  }
+}
@@ -0,0 +1,11 @@
+machine "frontend-1" {
+  kind = "ssh"
+  destination = "10.5.32.1"
+  auth {
+      kind = "agent"
+  }
+  vars {
+      listen_port = "8080"
+      environment = "production"
+  }
+}
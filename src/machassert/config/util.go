@@ -1,5 +1,11 @@
 package config
 
+import (
+	"errors"
+	"os"
+	"strconv"
+)
+
 // DefaultTarget returns a target object that represents the machine the binary is currently running on.
 func DefaultTarget() *MachineSpec {
 	return &MachineSpec{
@@ -11,3 +17,22 @@ func DefaultTarget() *MachineSpec {
 		},
 	}
 }
+
+// ParseMode parses an octal permissions string such as "0644".
+func ParseMode(mode string) (os.FileMode, error) {
+	m, err := strconv.ParseUint(mode, 8, 32)
+	if err != nil || m > 07777 {
+		return 0, errors.New("mode must be octal permissions (eg \"0644\"), got: " + mode)
+	}
+	out := os.FileMode(m & 0777)
+	if m&04000 != 0 {
+		out |= os.ModeSetuid
+	}
+	if m&02000 != 0 {
+		out |= os.ModeSetgid
+	}
+	if m&01000 != 0 {
+		out |= os.ModeSticky
+	}
+	return out, nil
+}
@@ -0,0 +1,332 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Variable describes the schema for a variable, which can be referenced in string fields as ${var.<name>}.
+type Variable struct {
+	Default string
+	Fields  []string `hcl:",decodedFields"` // set by the decoder, to tell an empty default from no default
+}
+
+// HasDefault returns true if the variable was declared with a default, which may be empty.
+func (v *Variable) HasDefault() bool {
+	for _, f := range v.Fields {
+		if f == "Default" {
+			return true
+		}
+	}
+	return false
+}
+
+// Scope holds the values available to ${...} interpolation.
+type Scope struct {
+	Vars    map[string]string // ${var.<name>}
+	Machine map[string]string // ${machine.<attribute>}
+
+	// partial scopes only replace the variables they contain, leaving other references
+	// (and escapes) to be interpolated later.
+	partial bool
+}
+
+// interpolationRegexp matches ${...} references, as well as $${...} which escapes a literal ${...}.
+var interpolationRegexp = regexp.MustCompile(`\$?\$\{[^}]*\}`)
+
+// referencePrefixes are the kinds of ${<prefix>.<name>} references which are interpolated. Any other ${...}
+// is left as-is, so shell parameter expansions like ${HOME} can be used in commands.
+var referencePrefixes = []string{"var", "machine", "env"}
+
+// parseReference returns the prefix and name of a ${...} match, or false if the match is escaped
+// or is not a reference.
+func parseReference(match string) (prefix, name string, ok bool) {
+	if strings.HasPrefix(match, "$$") {
+		return "", "", false
+	}
+	ref := strings.TrimSpace(match[2 : len(match)-1])
+	for _, p := range referencePrefixes {
+		if strings.HasPrefix(ref, p+".") {
+			return p, ref[len(p)+1:], true
+		}
+	}
+	return "", "", false
+}
+
+// hasInterpolation returns true if s contains ${...} references, so cannot be validated until it is interpolated.
+func hasInterpolation(s string) bool {
+	for _, match := range interpolationRegexp.FindAllString(s, -1) {
+		if _, _, ok := parseReference(match); ok || strings.HasPrefix(match, "$$") {
+			return true
+		}
+	}
+	return false
+}
+
+// Interpolate replaces ${var.<name>}, ${machine.<attribute>} and ${env.<NAME>} references in the string.
+func (s *Scope) Interpolate(in string) (string, error) {
+	var err error
+	out := interpolationRegexp.ReplaceAllStringFunc(in, func(match string) string {
+		if strings.HasPrefix(match, "$$") {
+			if s.partial {
+				return match
+			}
+			return match[1:]
+		}
+		prefix, name, ok := parseReference(match)
+		if !ok || err != nil {
+			return match
+		}
+		if s.partial {
+			if val, ok := s.Vars[name]; ok && prefix == "var" {
+				return val
+			}
+			return match
+		}
+		var val string
+		val, err = s.lookup(prefix, name)
+		return val
+	})
+	return out, err
+}
+
+func (s *Scope) lookup(prefix, name string) (string, error) {
+	if name == "" {
+		return "", errors.New("invalid interpolation: ${" + prefix + ".}")
+	}
+
+	switch prefix {
+	case "var":
+		if val, ok := s.Vars[name]; ok {
+			return val, nil
+		}
+		return "", errors.New("undefined variable: " + name)
+	case "machine":
+		if val, ok := s.Machine[name]; ok {
+			return val, nil
+		}
+		return "", errors.New("unknown machine attribute: " + name)
+	default:
+		if val, ok := os.LookupEnv(name); ok {
+			return val, nil
+		}
+		return "", errors.New("environment variable is not set: " + name)
+	}
+}
+
+// checkReferences returns an error if a string field of v references a variable which is not declared in vars, or
+// an unknown machine attribute. Environment variables are only checked when the references are resolved.
+func checkReferences(v reflect.Value, vars map[string]*Variable) error {
+	declared := map[string]string{}
+	for name := range vars {
+		declared[name] = ""
+	}
+	scope := machineScope("", &Machine{})
+	scope.Vars = declared
+
+	var err error
+	walkStrings(v, func(str string) {
+		for _, match := range interpolationRegexp.FindAllString(str, -1) {
+			prefix, name, ok := parseReference(match)
+			if !ok || err != nil || prefix == "env" {
+				continue
+			}
+			_, err = scope.lookup(prefix, name)
+		}
+	})
+	return err
+}
+
+// checkAssertionReferences calls checkReferences for each assertion, in name order.
+func checkAssertionReferences(assertions map[string]*Assertion, vars map[string]*Variable) error {
+	names := make([]string, 0, len(assertions))
+	for name := range assertions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := checkReferences(reflect.ValueOf(assertions[name]), vars); err != nil {
+			return errors.New(name + ": " + err.Error())
+		}
+	}
+	return nil
+}
+
+// walkStrings calls fn with every string in v, other than those in Variables & Vars fields.
+func walkStrings(v reflect.Value, fn func(string)) {
+	switch v.Kind() {
+	case reflect.String:
+		fn(v.String())
+	case reflect.Ptr:
+		if !v.IsNil() {
+			walkStrings(v.Elem(), fn)
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if name := v.Type().Field(i).Name; name != "Variables" && name != "Vars" {
+				walkStrings(v.Field(i), fn)
+			}
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			walkStrings(v.Index(i), fn)
+		}
+	case reflect.Map:
+		for _, k := range v.MapKeys() {
+			walkStrings(v.MapIndex(k), fn)
+		}
+	}
+}
+
+// machineScope returns a scope with the attributes of the named machine, and no variables.
+func machineScope(name string, m *Machine) *Scope {
+	return &Scope{
+		Vars: map[string]string{},
+		Machine: map[string]string{
+			"name":        name,
+			"kind":        m.Kind,
+			"destination": m.Destination,
+			"username":    m.Username,
+		},
+	}
+}
+
+// resolveVariables sets the Vars of each machine to its variables (the targets file variables, overridden by
+// the variable blocks of the machine, overridden by its vars block), and interpolates the fields of the machine.
+// Variable values may reference ${machine.<attribute>} and ${env.<NAME>}, but not other variables.
+func resolveVariables(spec *MachineSpec) error {
+	for name, m := range spec.Machine {
+		attrs := machineScope(name, m)
+		vars := map[string]string{}
+		for _, defaults := range []map[string]*Variable{spec.Variables, m.Variables} {
+			for k, v := range defaults {
+				val, err := attrs.Interpolate(v.Default)
+				if err != nil {
+					return errors.New("machine " + name + ": variable " + k + ": " + err.Error())
+				}
+				vars[k] = val
+			}
+		}
+		for k, v := range m.Vars {
+			val, err := attrs.Interpolate(v)
+			if err != nil {
+				return errors.New("machine " + name + ": variable " + k + ": " + err.Error())
+			}
+			vars[k] = val
+		}
+
+		scope := &Scope{Vars: vars, Machine: attrs.Machine}
+		out, err := interpolateValue(reflect.ValueOf(m), scope)
+		if err != nil {
+			return errors.New("machine " + name + ": " + err.Error())
+		}
+		*m = *out.Interface().(*Machine)
+		m.Vars = vars
+	}
+	return nil
+}
+
+// ForMachine returns a copy of the spec with all ${...} references resolved for the named machine, which is
+// then validated. Variables of the spec are overridden by those of the machine.
+func (spec *AssertionSpec) ForMachine(name string, m *Machine) (*AssertionSpec, error) {
+	scope := machineScope(name, m)
+	for k, v := range spec.Variables {
+		val, err := scope.Interpolate(v.Default)
+		if err != nil {
+			return nil, errors.New(spec.Name + ": variable " + k + ": " + err.Error())
+		}
+		scope.Vars[k] = val
+	}
+	for k, v := range m.Vars {
+		scope.Vars[k] = v
+	}
+
+	out := &AssertionSpec{Name: spec.Name, Variables: spec.Variables, Assertions: map[string]*Assertion{}}
+	for assertionName, a := range spec.Assertions {
+		v, err := interpolateValue(reflect.ValueOf(a), scope)
+		if err != nil {
+			return nil, errors.New(spec.Name + "." + assertionName + ": " + err.Error())
+		}
+		out.Assertions[assertionName] = v.Interface().(*Assertion)
+	}
+
+	if err := checkAssertionSpec(out); err != nil {
+		return nil, errors.New(spec.Name + ": " + err.Error())
+	}
+	return out, nil
+}
+
+// interpolateValue returns a deep copy of v, with every string interpolated. Variables & Vars fields
+// are copied as-is, as they are resolved separately.
+func interpolateValue(v reflect.Value, s *Scope) (reflect.Value, error) {
+	switch v.Kind() {
+	case reflect.String:
+		str, err := s.Interpolate(v.String())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(str).Convert(v.Type()), nil
+
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v, nil
+		}
+		elem, err := interpolateValue(v.Elem(), s)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		out := reflect.New(v.Type().Elem())
+		out.Elem().Set(elem)
+		return out, nil
+
+	case reflect.Struct:
+		out := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.NumField(); i++ {
+			if name := v.Type().Field(i).Name; name == "Variables" || name == "Vars" {
+				out.Field(i).Set(v.Field(i))
+				continue
+			}
+			field, err := interpolateValue(v.Field(i), s)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			out.Field(i).Set(field)
+		}
+		return out, nil
+
+	case reflect.Slice:
+		if v.IsNil() {
+			return v, nil
+		}
+		out := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			elem, err := interpolateValue(v.Index(i), s)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			out.Index(i).Set(elem)
+		}
+		return out, nil
+
+	case reflect.Map:
+		if v.IsNil() {
+			return v, nil
+		}
+		out := reflect.MakeMapWithSize(v.Type(), v.Len())
+		for _, k := range v.MapKeys() {
+			elem, err := interpolateValue(v.MapIndex(k), s)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			out.SetMapIndex(k, elem)
+		}
+		return out, nil
+
+	default:
+		return v, nil
+	}
+}
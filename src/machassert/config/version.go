@@ -0,0 +1,162 @@
+package config
+
+import (
+	"errors"
+	"strings"
+)
+
+// VersionConstraint restricts the acceptable versions of a package.
+type VersionConstraint struct {
+	Op      string // one of =, !=, <, <=, >, >=
+	Version string
+}
+
+var versionOps = []string{"==", "!=", ">=", "<=", "=", ">", "<"}
+
+// ParseVersionConstraint parses a constraint such as ">= 1.2.3". A version without an operator must match exactly.
+func ParseVersionConstraint(s string) (*VersionConstraint, error) {
+	s = strings.TrimSpace(s)
+	op := "="
+	for _, o := range versionOps {
+		if strings.HasPrefix(s, o) {
+			op = o
+			s = strings.TrimSpace(s[len(o):])
+			break
+		}
+	}
+	if op == "==" {
+		op = "="
+	}
+	if s == "" || strings.ContainsAny(s, " \t") {
+		return nil, errors.New("invalid version constraint: " + s)
+	}
+	return &VersionConstraint{Op: op, Version: s}, nil
+}
+
+// Matches returns true if version satisfies the constraint.
+func (c *VersionConstraint) Matches(version string) bool {
+	cmp := CompareVersions(version, c.Version)
+	switch c.Op {
+	case "!=":
+		return cmp != 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	default:
+		return cmp == 0
+	}
+}
+
+func (c *VersionConstraint) String() string {
+	return c.Op + " " + c.Version
+}
+
+// CompareVersions compares two package versions using the dpkg algorithm ([epoch:]upstream[-revision]),
+// returning -1, 0 or 1 if a is older than, the same as, or newer than b. The algorithm also gives
+// sensible results for rpm and apk versions.
+func CompareVersions(a, b string) int {
+	epochA, a := splitEpoch(a)
+	epochB, b := splitEpoch(b)
+	if c := compareNumeric(epochA, epochB); c != 0 {
+		return c
+	}
+
+	upstreamA, revisionA := splitRevision(a)
+	upstreamB, revisionB := splitRevision(b)
+	if c := compareFragment(upstreamA, upstreamB); c != 0 {
+		return c
+	}
+	return compareFragment(revisionA, revisionB)
+}
+
+func splitEpoch(v string) (string, string) {
+	if i := strings.Index(v, ":"); i >= 0 {
+		return v[:i], v[i+1:]
+	}
+	return "0", v
+}
+
+func splitRevision(v string) (string, string) {
+	if i := strings.LastIndex(v, "-"); i >= 0 {
+		return v[:i], v[i+1:]
+	}
+	return v, ""
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// compareFragment compares alternating runs of non-digits (lexically, with ~ sorting before
+// everything and letters before other characters) and digits (numerically).
+func compareFragment(a, b string) int {
+	for a != "" || b != "" {
+		var nonDigitA, nonDigitB string
+		nonDigitA, a = splitRun(a, false)
+		nonDigitB, b = splitRun(b, false)
+		if c := compareNonDigits(nonDigitA, nonDigitB); c != 0 {
+			return c
+		}
+
+		var digitA, digitB string
+		digitA, a = splitRun(a, true)
+		digitB, b = splitRun(b, true)
+		if c := compareNumeric(digitA, digitB); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+func splitRun(s string, digits bool) (string, string) {
+	i := 0
+	for i < len(s) && isDigit(s[i]) == digits {
+		i++
+	}
+	return s[:i], s[i:]
+}
+
+func charOrder(s string, i int) int {
+	if i >= len(s) {
+		return 0
+	}
+	c := s[i]
+	switch {
+	case c == '~':
+		return -1
+	case (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z'):
+		return int(c)
+	default:
+		return int(c) + 256
+	}
+}
+
+func compareNonDigits(a, b string) int {
+	for i := 0; i < len(a) || i < len(b); i++ {
+		oa, ob := charOrder(a, i), charOrder(b, i)
+		if oa < ob {
+			return -1
+		}
+		if oa > ob {
+			return 1
+		}
+	}
+	return 0
+}
+
+func compareNumeric(a, b string) int {
+	a = strings.TrimLeft(a, "0")
+	b = strings.TrimLeft(b, "0")
+	if len(a) != len(b) {
+		if len(a) < len(b) {
+			return -1
+		}
+		return 1
+	}
+	return strings.Compare(a, b)
+}
@@ -0,0 +1,52 @@
+package config
+
+import "testing"
+
+func TestCompareVersions(t *testing.T) {
+	tcs := []struct {
+		a, b string
+		want int
+	}{
+		{"1.2.3", "1.2.3", 0},
+		{"1.2.3", "1.2.10", -1},
+		{"1.10", "1.9", 1},
+		{"1:1.0", "2.0", 1},
+		{"1.0~rc1", "1.0", -1},
+		{"1.0-1ubuntu1", "1.0-1", 1},
+		{"1.0a", "1.0", 1},
+		{"1.0.0", "1.0", 1},
+		{"007", "7", 0},
+	}
+	for _, tc := range tcs {
+		if got := CompareVersions(tc.a, tc.b); got != tc.want {
+			t.Errorf("CompareVersions(%q, %q) = %d, want %d", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
+
+func TestVersionConstraint(t *testing.T) {
+	tcs := []struct {
+		constraint, version string
+		want                bool
+	}{
+		{"1.2.3", "1.2.3", true},
+		{"= 1.2.3", "1.2.4", false},
+		{">= 1.2", "1.10", true},
+		{"<1.2", "1.10", false},
+		{"!= 2.0", "2.0", false},
+		{"> 2.0-1", "2.0-2", true},
+	}
+	for _, tc := range tcs {
+		c, err := ParseVersionConstraint(tc.constraint)
+		if err != nil {
+			t.Fatalf("ParseVersionConstraint(%q) failed: %v", tc.constraint, err)
+		}
+		if got := c.Matches(tc.version); got != tc.want {
+			t.Errorf("%q.Matches(%q) = %v, want %v", tc.constraint, tc.version, got, tc.want)
+		}
+	}
+
+	if _, err := ParseVersionConstraint(">="); err == nil {
+		t.Error("Expected error for constraint without version")
+	}
+}
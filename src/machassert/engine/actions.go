@@ -2,13 +2,24 @@ package engine
 
 import (
 	"errors"
+	"fmt"
 	"io"
 	"machassert/config"
 	"machassert/util"
 	"os"
 )
 
-func doAction(machine Machine, assertion *config.Assertion, action *config.Action, e *Executor, printPrefix string) error {
+func doAction(machine Machine, assertion *config.Assertion, action *config.Action, result *AssertionResult, e *Executor, printPrefix string) error {
+	if e.dryRun && action.Kind != "" {
+		if action.Kind == config.ActionFail { // changes nothing, so is not part of the plan
+			return nil
+		}
+		e.recordPlannedAction(machine, action, printPrefix)
+		if action.Kind != config.ActionAssert {
+			return nil
+		}
+	}
+
 	switch action.Kind {
 	case "":
 		return nil
@@ -18,38 +29,100 @@ func doAction(machine Machine, assertion *config.Assertion, action *config.Actio
 		return copyAction(machine, assertion, action)
 	case config.ActionAssert:
 		return assertAction(machine, assertion, action, e, printPrefix)
+	case config.ActionInstallPackage:
+		return installPackageAction(machine, assertion, action)
+	case config.ActionService:
+		return serviceAction(machine, assertion, action)
+	case config.ActionRun:
+		return runAction(machine, action, result)
+	case config.ActionTemplate:
+		return templateAction(machine, action, e)
+	case config.ActionMkdir:
+		return mkdirAction(machine, action)
+	case config.ActionDelete:
+		return machine.Remove(action.Path, action.Recursive)
+	case config.ActionSymlink:
+		return machine.Symlink(action.Target, action.Path)
+	case config.ActionChmod:
+		return chmodAction(machine, action)
+	case config.ActionChown:
+		return machine.Chown(action.Path, action.Owner, action.Group)
 	default:
 		return errors.New("Unrecognised actions kind: " + action.Kind)
 	}
 }
 
+// assertAction runs the nested assertions, returning the first error. Nested assertions are subject to
+// ignore_errors and on_failure in the same way as top-level assertions.
 func assertAction(machine Machine, assertion *config.Assertion, action *config.Action, e *Executor, printPrefix string) error {
+	var firstErr error
 	for _, assertionName := range sortAssertions(action.Assertions) {
 		assertion := action.Assertions[assertionName]
-		e.logger.LogAssertionStatus(printPrefix, assertionName, assertion, nil, nil)
-		result, err := applyAssertion(machine, assertion, e, printPrefix+"."+assertionName)
-		e.logger.LogAssertionStatus(printPrefix, assertionName, assertion, result, err)
-		if err != nil {
-			return err
+		err := e.runAssertion(machine, printPrefix, assertionName, assertion)
+		if err == nil || assertion.IgnoreErrors {
+			continue
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+		if !e.continueAfter(assertion) {
+			break
 		}
 	}
-
-	return nil
+	return firstErr
 }
 
 func copyAction(machine Machine, assertion *config.Assertion, action *config.Action) error {
-	output, err := machine.WriteFile(action.DestinationPath)
+	input, err := os.Open(util.PathSanitize(action.SourcePath))
 	if err != nil {
 		return err
 	}
-	defer output.Close()
+	defer input.Close()
 
-	input, err := os.Open(util.PathSanitize(action.SourcePath))
+	output, err := machine.WriteFile(action.DestinationPath)
 	if err != nil {
 		return err
 	}
-	defer input.Close()
 
 	_, err = io.Copy(output, input)
-	return err
+	if err != nil {
+		output.Close()
+		return err
+	}
+	if err = output.Close(); err != nil {
+		return err
+	}
+
+	if action.Mode != "" {
+		mode, err := config.ParseMode(action.Mode)
+		if err != nil {
+			return err
+		}
+		if err = machine.Chmod(action.DestinationPath, mode); err != nil {
+			return err
+		}
+	}
+	if action.Owner != "" || action.Group != "" {
+		return machine.Chown(action.DestinationPath, action.Owner, action.Group)
+	}
+	return nil
+}
+
+func runAction(m Machine, action *config.Action, result *AssertionResult) error {
+	cmd, err := shellCommand(action.Command, action.Timeout)
+	if err != nil {
+		return err
+	}
+	cmd.Dir = action.Dir
+	cmd.Env = action.Env
+	cmd.Stdin = []byte(action.Stdin)
+
+	out, err := execCommand(m, cmd, action.Command, result)
+	if err != nil {
+		return err
+	}
+	if !exitStatusAccepted(out.ExitStatus, action.ExitCodes) {
+		return fmt.Errorf("%q exited with status %d", action.Command, out.ExitStatus)
+	}
+	return nil
 }
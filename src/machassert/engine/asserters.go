@@ -1,11 +1,11 @@
 package engine
 
 import (
-	"crypto/md5"
 	"encoding/hex"
 	"errors"
 	"io"
 	"machassert/config"
+	"machassert/machine"
 	"machassert/util"
 	"os"
 	"strings"
@@ -21,11 +21,24 @@ const (
 	AssertionFailed
 	AssertionError
 	AssertionApplyError
+	AssertionSkipped // not run, as it was not selected or an assertion it depends on failed
 )
 
 // AssertionResult captures what happens when an assertion is applied.
 type AssertionResult struct {
 	Result int
+	// Output holds the output of commands run by the assertion or its actions, so it can be reported on failure.
+	Output []*CommandOutput
+	// Actions holds the actions which were run (or in a dry run, would have been run), in order.
+	Actions []*config.Action
+}
+
+// CommandOutput is the output of a command run on a machine.
+type CommandOutput struct {
+	Command    string `json:"command"`
+	ExitStatus int    `json:"exit_status"`
+	Stdout     string `json:"stdout"`
+	Stderr     string `json:"stderr"`
 }
 
 func (r AssertionResult) String() string {
@@ -40,11 +53,18 @@ func (r AssertionResult) String() string {
 		return "ERR"
 	case AssertionApplyError:
 		return "APPLY_ERR"
+	case AssertionSkipped:
+		return "SKIPPED"
 	default:
 		return "?"
 	}
 }
 
+// IsError returns true if the assertion could not be evaluated or its actions could not be applied.
+func (r AssertionResult) IsError() bool {
+	return r.Result == AssertionError || r.Result == AssertionApplyError
+}
+
 func applyAssertion(machine Machine, assertion *config.Assertion, e *Executor, printPrefix string) (*AssertionResult, error) {
 	result := &AssertionResult{Result: AssertionError}
 	var err error
@@ -60,15 +80,27 @@ func applyAssertion(machine Machine, assertion *config.Assertion, e *Executor, p
 		result, err = applyHashFileMatchAssertion(machine, assertion)
 	case config.RegexMatchAssrt:
 		result, err = applyRegexContentsAssertion(machine, assertion)
+	case config.PackageInstalledAssrt:
+		result, err = applyPackageInstalledAssertion(machine, assertion)
+	case config.ServiceRunningAssrt, config.ServiceEnabledAssrt:
+		result, err = applyServiceAssertion(machine, assertion)
+	case config.CommandAssrt:
+		result, err = applyCommandAssertion(machine, assertion)
+	case config.FileAttributesAssrt:
+		result, err = applyFileAttributesAssertion(machine, assertion)
+	case config.TemplateMatchAssrt:
+		result, err = applyTemplateMatchAssertion(machine, assertion, e)
 	default:
 		err = errors.New("unknown assertion kind: " + assertion.Kind)
 	}
 
 	if err == nil && result.Result == AssertionApplied { //apply the actions
 		for _, action := range assertion.Actions {
-			err = doAction(machine, assertion, action, e, printPrefix)
+			result.Actions = append(result.Actions, action)
+			err = doAction(machine, assertion, action, result, e, printPrefix)
 			if err == ErrAssertionsFailed {
 				result.Result = AssertionFailed
+				return result, err
 			}
 			if err != nil {
 				result.Result = AssertionApplyError
@@ -105,7 +137,7 @@ func applyNotExistsAssertion(machine Machine, assertion *config.Assertion) (*Ass
 }
 
 func applyHashAssertion(machine Machine, assertion *config.Assertion) (*AssertionResult, error) {
-	hash, err := machine.Hash(assertion.FilePath)
+	hash, err := machine.Hash(assertion.FilePath, assertion.HashAlgorithm)
 	if err != nil {
 		return &AssertionResult{Result: AssertionError}, err
 	}
@@ -126,9 +158,9 @@ func applyRegexContentsAssertion(machine Machine, assertion *config.Assertion) (
 	return &AssertionResult{Result: AssertionNoop}, nil
 }
 
-func applyHashFileMatchAssertion(machine Machine, assertion *config.Assertion) (*AssertionResult, error) {
+func applyHashFileMatchAssertion(m Machine, assertion *config.Assertion) (*AssertionResult, error) {
 	// first check file exists
-	f, err := machine.ReadFile(assertion.FilePath)
+	f, err := m.ReadFile(assertion.FilePath)
 	if err != nil && os.IsNotExist(err) {
 		return &AssertionResult{Result: AssertionApplied}, nil
 	}
@@ -137,7 +169,10 @@ func applyHashFileMatchAssertion(machine Machine, assertion *config.Assertion) (
 	}
 	f.Close()
 
-	hasher := md5.New()
+	hasher, err := machine.NewHash(assertion.HashAlgorithm)
+	if err != nil {
+		return &AssertionResult{Result: AssertionError}, err
+	}
 	localFile, err := os.Open(util.PathSanitize(assertion.BasePath))
 	if err != nil {
 		return &AssertionResult{Result: AssertionError}, err
@@ -150,7 +185,7 @@ func applyHashFileMatchAssertion(machine Machine, assertion *config.Assertion) (
 	}
 	localHash := hex.EncodeToString(hasher.Sum(nil))
 
-	hash, err := machine.Hash(assertion.FilePath)
+	hash, err := m.Hash(assertion.FilePath, assertion.HashAlgorithm)
 	if err != nil {
 		return &AssertionResult{Result: AssertionError}, err
 	}
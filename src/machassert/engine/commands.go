@@ -0,0 +1,88 @@
+package engine
+
+import (
+	"machassert/config"
+	"machassert/machine"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// shellCommand returns a command which runs command with sh -c, with the given timeout (if not empty).
+func shellCommand(command, timeout string) (*machine.Command, error) {
+	cmd := &machine.Command{
+		Name: "sh",
+		Args: []string{"-c", command},
+	}
+	if timeout != "" {
+		d, err := time.ParseDuration(timeout)
+		if err != nil {
+			return nil, err
+		}
+		cmd.Timeout = d
+	}
+	return cmd, nil
+}
+
+// execCommand runs the command on the machine, recording its output in result under the given name.
+func execCommand(m Machine, cmd *machine.Command, name string, result *AssertionResult) (*machine.CommandResult, error) {
+	out, err := m.Exec(cmd)
+	if out != nil {
+		result.Output = append(result.Output, &CommandOutput{
+			Command:    name,
+			ExitStatus: out.ExitStatus,
+			Stdout:     string(out.Stdout),
+			Stderr:     string(out.Stderr),
+		})
+	}
+	return out, err
+}
+
+func exitStatusAccepted(status int, accepted []int) bool {
+	for _, code := range accepted {
+		if status == code {
+			return true
+		}
+	}
+	return false
+}
+
+// outputMatches returns true if output matches the exact string (if set, ignoring surrounding whitespace) and the regular expression (if set).
+func outputMatches(output []byte, exact, regex string) (bool, error) {
+	if exact != "" && strings.TrimSpace(string(output)) != strings.TrimSpace(exact) {
+		return false, nil
+	}
+	if regex != "" {
+		r, err := regexp.Compile(regex)
+		if err != nil {
+			return false, err
+		}
+		return r.Match(output), nil
+	}
+	return true, nil
+}
+
+func applyCommandAssertion(m Machine, assertion *config.Assertion) (*AssertionResult, error) {
+	result := &AssertionResult{Result: AssertionError}
+	cmd, err := shellCommand(assertion.Command, assertion.Timeout)
+	if err != nil {
+		return result, err
+	}
+	out, err := execCommand(m, cmd, assertion.Command, result)
+	if err != nil {
+		return result, err
+	}
+
+	result.Result = AssertionApplied
+	if !exitStatusAccepted(out.ExitStatus, assertion.ExitCodes) {
+		return result, nil
+	}
+	if ok, err := outputMatches(out.Stdout, assertion.Stdout, assertion.StdoutRegex); !ok || err != nil {
+		return result, err
+	}
+	if ok, err := outputMatches(out.Stderr, assertion.Stderr, assertion.StderrRegex); !ok || err != nil {
+		return result, err
+	}
+	result.Result = AssertionNoop
+	return result, nil
+}
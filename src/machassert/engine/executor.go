@@ -5,13 +5,33 @@ import (
 	"machassert/config"
 	"machassert/machine"
 	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
 )
 
 // Executor stores state/configuration for applying assertions to targets.
 type Executor struct {
-	machines   *config.MachineSpec
-	assertions []*config.AssertionSpec
-	logger     Logger
+	machines    *config.MachineSpec
+	assertions  []*config.AssertionSpec
+	logger      Logger
+	parallelism int
+	connectOpts machine.ConnectOptions
+
+	assertionParallelism int
+	continueOnFailure    bool
+	filter               AssertionFilter
+
+	failuresLock sync.Mutex
+	failures     []*Failure
+	resultsLock  sync.Mutex
+	results      []*Result
+
+	dryRun   bool
+	planLock sync.Mutex
+	plan     []*PlannedAction
+
+	connect func(name string, m *config.Machine, l Logger, opts machine.ConnectOptions) (Machine, error) // replaced in tests
 }
 
 // New creates a new executor.
@@ -20,27 +40,88 @@ func New(machines *config.MachineSpec, assertions []*config.AssertionSpec) *Exec
 		machines:   machines,
 		assertions: assertions,
 		logger:     &ConsoleLogger{},
+		connect:    connect,
+	}
+}
+
+// SetParallelism sets the maximum number of machines which are asserted on concurrently,
+// overriding the value in the targets file. Values less than one are ignored.
+func (e *Executor) SetParallelism(n int) {
+	e.parallelism = n
+}
+
+// SetAssertionParallelism sets the maximum number of assertions run concurrently on each machine.
+// Only assertions whose depends_on have all held are run concurrently. Values less than one are ignored.
+func (e *Executor) SetAssertionParallelism(n int) {
+	e.assertionParallelism = n
+}
+
+func (e *Executor) maxAssertionParallelism() int {
+	if e.assertionParallelism > 0 {
+		return e.assertionParallelism
+	}
+	return 1
+}
+
+// SetContinueOnFailure configures whether assertions (and machines) are still run after an assertion
+// fails or a machine cannot be connected to, as if every assertion had on_failure = "continue".
+// Run still returns the first error.
+func (e *Executor) SetContinueOnFailure(continueOnFailure bool) {
+	e.continueOnFailure = continueOnFailure
+}
+
+// SetLogger sets the logger which run status is reported to, instead of the default ConsoleLogger.
+func (e *Executor) SetLogger(l Logger) {
+	e.logger = l
+}
+
+// SetConnectOptions configures how connections to remote machines are established.
+func (e *Executor) SetConnectOptions(opts machine.ConnectOptions) {
+	e.connectOpts = opts
+}
+
+func (e *Executor) maxParallelism() int {
+	if e.parallelism > 0 {
+		return e.parallelism
 	}
+	if e.machines.Parallelism > 0 {
+		return e.machines.Parallelism
+	}
+	return 1
 }
 
 // Run applies the assertions in the executor to the machines it knows about.
+// Machines are processed in name order, up to maxParallelism() at a time. Once a machine
+// returns an error no further machines are started, and the error of the first failing
+// machine (in name order) is returned once in-flight machines have finished.
 func (e *Executor) Run() error {
-	for name, machine := range e.machines.Machine {
-		e.logger.LogMachineStatus(name, false, machine, nil)
-		m, err := connect(name, machine, e.logger)
-		e.logger.LogMachineStatus(name, true, machine, err)
-		if err != nil {
-			return err
+	names := sortMachines(e.machines.Machine)
+	errs := make([]error, len(names))
+	sem := make(chan bool, e.maxParallelism())
+	var wg sync.WaitGroup
+	var aborted int32
+
+	for i, name := range names {
+		sem <- true
+		if atomic.LoadInt32(&aborted) != 0 {
+			<-sem
+			break
 		}
 
-		for _, assertions := range e.assertions {
-			err = e.runAssertionOnMachine(m, assertions)
-			if err != nil {
-				m.Close()
-				return err
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			errs[i] = e.runOnMachine(name, e.machines.Machine[name])
+			if errs[i] != nil && !e.continueOnFailure {
+				atomic.StoreInt32(&aborted, 1)
 			}
-		}
-		err = m.Close()
+		}(i, name)
+	}
+	wg.Wait()
+	e.logger.LogRunSummary()
+
+	for _, err := range errs {
 		if err != nil {
 			return err
 		}
@@ -48,6 +129,49 @@ func (e *Executor) Run() error {
 	return nil
 }
 
+func (e *Executor) runOnMachine(name string, machine *config.Machine) error {
+	e.logger.LogMachineStatus(name, false, machine, nil)
+	m, err := e.connect(name, machine, e.logger, e.connectOpts)
+	e.logger.LogMachineStatus(name, true, machine, err)
+	if err != nil {
+		e.recordFailure(&Failure{Machine: name, Err: err})
+		return err
+	}
+
+	nodes, err := e.assertionGraph(name, machine)
+	if err != nil {
+		e.recordFailure(&Failure{Machine: name, Err: err})
+		m.Close()
+		return err
+	}
+	if err = e.runAssertionGraph(m, nodes); err != nil {
+		m.Close()
+		return err
+	}
+	return m.Close()
+}
+
+// assertionGraph returns the assertions to run on the named machine, with ${...} references resolved.
+func (e *Executor) assertionGraph(name string, machine *config.Machine) ([]*assertionNode, error) {
+	specs := make([]*config.AssertionSpec, len(e.assertions))
+	for i := range e.assertions {
+		var err error
+		if specs[i], err = e.assertions[i].ForMachine(name, machine); err != nil {
+			return nil, err
+		}
+	}
+	return buildAssertionGraph(specs)
+}
+
+func sortMachines(machines map[string]*config.Machine) []string {
+	out := make([]string, 0, len(machines))
+	for name := range machines {
+		out = append(out, name)
+	}
+	sort.Strings(out)
+	return out
+}
+
 type assertionForSort struct {
 	name      string
 	assertion *config.Assertion
@@ -83,25 +207,69 @@ func (a ByOrder) keys() []string {
 	return out
 }
 
-func (e *Executor) runAssertionOnMachine(machine Machine, assertions *config.AssertionSpec) error {
-	for _, assertionName := range sortAssertions(assertions.Assertions) {
-		assertion := assertions.Assertions[assertionName]
-		e.logger.LogAssertionStatus(assertions.Name, assertionName, assertion, nil, nil)
-		result, err := applyAssertion(machine, assertion, e, assertions.Name+"."+assertionName)
-		e.logger.LogAssertionStatus(assertions.Name, assertionName, assertion, result, err)
-		if err != nil {
-			return err
-		}
+// runAssertion applies the assertion, recording its result, and recording it as a failure if it returns
+// an error. specName is the name of the spec, or the qualified name of the assertion owning a nested ASSERT action.
+// Failures of nested assertions are only recorded against the nested assertion, not the assertion owning it.
+func (e *Executor) runAssertion(machine Machine, specName, assertionName string, assertion *config.Assertion) error {
+	e.logger.LogAssertionStatus(machine.Name(), specName, assertionName, assertion, nil, nil)
+	start := time.Now()
+	result, err := applyAssertion(machine, assertion, e, specName+"."+assertionName)
+	e.recordResult(&Result{
+		Machine:   machine.Name(),
+		Assertion: specName + "." + assertionName,
+		Kind:      assertion.Kind,
+		Result:    result,
+		Err:       err,
+		Ignored:   assertion.IgnoreErrors,
+		Start:     start,
+		Duration:  time.Since(start),
+	})
+	e.logger.LogAssertionStatus(machine.Name(), specName, assertionName, assertion, result, err)
+	if err != nil && !failedInNestedAssertion(result) {
+		e.recordFailure(&Failure{
+			Machine:   machine.Name(),
+			Assertion: specName + "." + assertionName,
+			Err:       err,
+			Ignored:   assertion.IgnoreErrors,
+		})
 	}
-	return nil
+	return err
+}
+
+// failedInNestedAssertion returns true if the assertion failed because an assertion of its ASSERT action
+// failed, which runAssertion has already recorded.
+func failedInNestedAssertion(result *AssertionResult) bool {
+	if result == nil || len(result.Actions) == 0 {
+		return false
+	}
+	return result.Actions[len(result.Actions)-1].Kind == config.ActionAssert
+}
+
+// skipAssertion reports and records that the assertion was not run, giving the reason why.
+func (e *Executor) skipAssertion(machine Machine, specName, assertionName string, assertion *config.Assertion, reason string) {
+	result := &AssertionResult{Result: AssertionSkipped}
+	e.recordResult(&Result{
+		Machine:    machine.Name(),
+		Assertion:  specName + "." + assertionName,
+		Kind:       assertion.Kind,
+		Result:     result,
+		SkipReason: reason,
+		Start:      time.Now(),
+	})
+	e.logger.LogAssertionStatus(machine.Name(), specName, assertionName, assertion, result, nil)
+}
+
+// continueAfter returns true if later assertions should still be run after the assertion fails.
+func (e *Executor) continueAfter(assertion *config.Assertion) bool {
+	return e.continueOnFailure || assertion.OnFailure == config.OnFailureContinue
 }
 
-func connect(name string, m *config.Machine, l Logger) (Machine, error) {
+func connect(name string, m *config.Machine, l Logger, opts machine.ConnectOptions) (Machine, error) {
 	switch m.Kind {
 	case config.KindLocal:
-		return machine.ConnectLocal(name, m)
+		return machine.ConnectLocal(name, m, l)
 	case config.KindSSH:
-		return machine.ConnectRemote(name, m, l)
+		return machine.ConnectRemote(name, m, l, opts)
 	}
 	return nil, errors.New("Could not interpret machine kind")
 }
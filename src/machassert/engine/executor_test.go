@@ -0,0 +1,217 @@
+package engine
+
+import (
+	"errors"
+	"io/ioutil"
+	"machassert/config"
+	"machassert/machine"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeMachine is a Machine which pretends to run commands. Each command takes delay, and exits with the
+// status given in exit (0 if not listed), or fails to run if it is listed in fail. It records how many
+// commands were running at once.
+type fakeMachine struct {
+	Machine
+	name  string
+	exit  map[string]int
+	fail  map[string]bool
+	delay time.Duration
+	conc  *concurrency // if set, counts the machine as running until it is closed
+
+	mu         sync.Mutex
+	running    int
+	maxRunning int
+}
+
+func (m *fakeMachine) Name() string {
+	return m.name
+}
+
+func (m *fakeMachine) Exec(cmd *machine.Command) (*machine.CommandResult, error) {
+	m.mu.Lock()
+	m.running++
+	if m.running > m.maxRunning {
+		m.maxRunning = m.running
+	}
+	m.mu.Unlock()
+	defer func() {
+		m.mu.Lock()
+		m.running--
+		m.mu.Unlock()
+	}()
+
+	time.Sleep(m.delay)
+	command := cmd.Args[len(cmd.Args)-1]
+	if m.fail[command] {
+		return nil, errors.New(m.name + ": " + command + " failed")
+	}
+	return &machine.CommandResult{ExitStatus: m.exit[command]}, nil
+}
+
+func (m *fakeMachine) Close() error {
+	if m.conc != nil {
+		m.conc.leave()
+	}
+	return nil
+}
+
+// concurrency records the order things started in, and how many were running at once.
+type concurrency struct {
+	mu         sync.Mutex
+	started    []string
+	running    int
+	maxRunning int
+}
+
+func (c *concurrency) enter(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.started = append(c.started, name)
+	c.running++
+	if c.running > c.maxRunning {
+		c.maxRunning = c.running
+	}
+}
+
+func (c *concurrency) leave() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.running--
+}
+
+// commandAssertion returns an assertion which fails unless command exits with status 0.
+func commandAssertion(command string) *config.Assertion {
+	return &config.Assertion{
+		Kind:      config.CommandAssrt,
+		Command:   command,
+		ExitCodes: []int{0},
+		OnFailure: config.OnFailureStop,
+		Actions:   []*config.Action{{Kind: config.ActionFail}},
+	}
+}
+
+func TestRun(t *testing.T) {
+	tcs := []struct {
+		name        string
+		machines    []string
+		slow        []string // machines whose assertion takes a while
+		fail        []string // machines whose assertion errors, after a short while
+		parallelism int
+		continueOn  bool
+
+		wantStarted []string
+		wantMax     int
+		wantErr     string
+	}{
+		{
+			name:        "sequential",
+			machines:    []string{"c", "a", "b"},
+			parallelism: 1,
+			wantStarted: []string{"a", "b", "c"},
+			wantMax:     1,
+		},
+		{
+			name:        "parallel",
+			machines:    []string{"e", "d", "c", "b", "a"},
+			slow:        []string{"a", "b", "c", "d", "e"},
+			parallelism: 3,
+			wantStarted: []string{"a", "b", "c", "d", "e"},
+			wantMax:     3,
+		},
+		{
+			name:        "stop",
+			machines:    []string{"a", "b", "c", "d"},
+			slow:        []string{"a"},
+			fail:        []string{"b"},
+			parallelism: 2,
+			wantStarted: []string{"a", "b"},
+			wantMax:     2,
+			wantErr:     "b: check failed",
+		},
+		{
+			name:        "stop sequential",
+			machines:    []string{"a", "b", "c"},
+			fail:        []string{"b"},
+			parallelism: 1,
+			wantStarted: []string{"a", "b"},
+			wantMax:     1,
+			wantErr:     "b: check failed",
+		},
+		{
+			name:        "first error by name",
+			machines:    []string{"a", "b", "c", "d"},
+			slow:        []string{"b"},
+			fail:        []string{"b", "c"},
+			parallelism: 2,
+			wantStarted: []string{"a", "b", "c"},
+			wantMax:     2,
+			wantErr:     "b: check failed", // c fails first, but b comes first by name
+		},
+		{
+			name:        "continue on failure",
+			machines:    []string{"a", "b", "c"},
+			fail:        []string{"a"},
+			parallelism: 1,
+			continueOn:  true,
+			wantStarted: []string{"a", "b", "c"},
+			wantMax:     1,
+			wantErr:     "a: check failed",
+		},
+	}
+
+	for _, tc := range tcs {
+		targets := &config.MachineSpec{Parallelism: tc.parallelism, Machine: map[string]*config.Machine{}}
+		for _, name := range tc.machines {
+			targets.Machine[name] = &config.Machine{Kind: config.KindLocal}
+		}
+		spec := &config.AssertionSpec{Name: "s", Assertions: map[string]*config.Assertion{"check": commandAssertion("check")}}
+
+		e := New(targets, []*config.AssertionSpec{spec})
+		e.SetLogger(NewJSONLogger(ioutil.Discard))
+		e.SetContinueOnFailure(tc.continueOn)
+		conc := &concurrency{}
+		e.connect = func(name string, m *config.Machine, l Logger, opts machine.ConnectOptions) (Machine, error) {
+			conc.enter(name)
+			fm := &fakeMachine{name: name, conc: conc, fail: map[string]bool{"check": contains(tc.fail, name)}}
+			if contains(tc.fail, name) {
+				fm.delay = 10 * time.Millisecond
+			}
+			if contains(tc.slow, name) {
+				fm.delay = 30 * time.Millisecond
+			}
+			return fm, nil
+		}
+
+		err := e.Run()
+		if (err == nil && tc.wantErr != "") || (err != nil && err.Error() != tc.wantErr) {
+			t.Errorf("%s: got error %v, want %q", tc.name, err, tc.wantErr)
+		}
+		started := append([]string(nil), conc.started...)
+		if tc.parallelism > 1 { // machines started together may connect in any order
+			sort.Strings(started)
+		}
+		if !reflect.DeepEqual(started, tc.wantStarted) {
+			t.Errorf("%s: started %v, want %v", tc.name, started, tc.wantStarted)
+		}
+		if conc.maxRunning != tc.wantMax {
+			t.Errorf("%s: %d machines ran at once, want %d", tc.name, conc.maxRunning, tc.wantMax)
+		}
+
+		var results []string
+		for _, r := range e.Results() {
+			results = append(results, r.Machine+" "+r.Assertion)
+		}
+		var want []string
+		for _, name := range tc.wantStarted {
+			want = append(want, name+" s.check")
+		}
+		if !reflect.DeepEqual(results, want) {
+			t.Errorf("%s: got results %v, want %v", tc.name, results, want)
+		}
+	}
+}
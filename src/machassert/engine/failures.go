@@ -0,0 +1,32 @@
+package engine
+
+import "sort"
+
+// Failure records an assertion which failed or errored on a machine, or a machine which could
+// not be asserted on at all.
+type Failure struct {
+	Machine   string
+	Assertion string // qualified name of the assertion, empty if the machine could not be asserted on
+	Err       error
+	Ignored   bool // the assertion has ignore_errors set, so the failure does not fail the run
+}
+
+func (e *Executor) recordFailure(f *Failure) {
+	e.failuresLock.Lock()
+	defer e.failuresLock.Unlock()
+	e.failures = append(e.failures, f)
+}
+
+// Failures returns every failure recorded by Run, ordered by machine name. Failures on a machine
+// are in the order they occurred.
+func (e *Executor) Failures() []*Failure {
+	e.failuresLock.Lock()
+	defer e.failuresLock.Unlock()
+
+	out := make([]*Failure, len(e.failures))
+	copy(out, e.failures)
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Machine < out[j].Machine
+	})
+	return out
+}
@@ -0,0 +1,102 @@
+package engine
+
+import (
+	"machassert/config"
+	"machassert/machine"
+	"os"
+	"strconv"
+)
+
+// modeBits are the parts of a file mode compared by file_attributes assertions.
+const modeBits = os.ModePerm | os.ModeSetuid | os.ModeSetgid | os.ModeSticky
+
+func applyFileAttributesAssertion(m Machine, assertion *config.Assertion) (*AssertionResult, error) {
+	info, err := m.Stat(assertion.FilePath)
+	if err != nil && os.IsNotExist(err) {
+		return &AssertionResult{Result: AssertionApplied}, nil
+	}
+	if err != nil {
+		return &AssertionResult{Result: AssertionError}, err
+	}
+
+	ok, err := fileAttributesMatch(info, assertion)
+	if err != nil {
+		return &AssertionResult{Result: AssertionError}, err
+	}
+	if !ok {
+		return &AssertionResult{Result: AssertionApplied}, nil
+	}
+	return &AssertionResult{Result: AssertionNoop}, nil
+}
+
+// fileAttributesMatch returns true if the file described by info satisfies every attribute set on the assertion.
+func fileAttributesMatch(info *machine.FileInfo, assertion *config.Assertion) (bool, error) {
+	if assertion.Mode != "" {
+		mode, err := config.ParseMode(assertion.Mode)
+		if err != nil {
+			return false, err
+		}
+		if info.Mode&modeBits != mode {
+			return false, nil
+		}
+	}
+	if assertion.Owner != "" && !idMatches(assertion.Owner, info.UID, info.Owner) {
+		return false, nil
+	}
+	if assertion.Group != "" && !idMatches(assertion.Group, info.GID, info.Group) {
+		return false, nil
+	}
+
+	switch assertion.FileType {
+	case "file":
+		if !info.Mode.IsRegular() {
+			return false, nil
+		}
+	case "directory":
+		if !info.Mode.IsDir() {
+			return false, nil
+		}
+	case "symlink":
+		if info.Mode&os.ModeSymlink == 0 {
+			return false, nil
+		}
+		if assertion.LinkTarget != "" && info.LinkTarget != assertion.LinkTarget {
+			return false, nil
+		}
+	}
+
+	if info.Size < assertion.MinSize {
+		return false, nil
+	}
+	if assertion.MaxSize > 0 && info.Size > assertion.MaxSize {
+		return false, nil
+	}
+	return true, nil
+}
+
+// idMatches compares a user or group from an assertion, which may be a name or a numeric ID.
+func idMatches(want string, id int, name string) bool {
+	if n, err := strconv.Atoi(want); err == nil {
+		return n == id
+	}
+	return want == name
+}
+
+func mkdirAction(m Machine, action *config.Action) error {
+	mode := os.FileMode(0755)
+	if action.Mode != "" {
+		var err error
+		if mode, err = config.ParseMode(action.Mode); err != nil {
+			return err
+		}
+	}
+	return m.Mkdir(action.Path, mode, action.Parents)
+}
+
+func chmodAction(m Machine, action *config.Action) error {
+	mode, err := config.ParseMode(action.Mode)
+	if err != nil {
+		return err
+	}
+	return m.Chmod(action.Path, mode)
+}
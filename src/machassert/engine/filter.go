@@ -0,0 +1,59 @@
+package engine
+
+import (
+	"errors"
+	"machassert/config"
+)
+
+// AssertionFilter selects which assertions are run. Assertions which are not selected are reported as
+// skipped, and do not stop assertions which depend on them from running. Assertions run by nested ASSERT
+// actions are always run.
+type AssertionFilter struct {
+	Tags     []string // if set, only assertions with at least one of these tags are run
+	SkipTags []string // assertions with any of these tags are not run
+	Only     []string // if set, only these assertions (<spec name>.<assertion name>) are run
+}
+
+// Check returns an error if Only references an assertion which is not in the specs.
+func (f *AssertionFilter) Check(specs []*config.AssertionSpec) error {
+	for _, ref := range f.Only {
+		if _, _, ok := config.ResolveAssertion(specs, ref); !ok {
+			return errors.New("unknown assertion: " + ref)
+		}
+	}
+	return nil
+}
+
+// selects returns true if the assertion should be run.
+func (f *AssertionFilter) selects(specName, name string, assertion *config.Assertion) bool {
+	if len(f.Only) > 0 && !contains(f.Only, specName+"."+name) {
+		return false
+	}
+	if len(f.Tags) > 0 && !hasAnyTag(assertion, f.Tags) {
+		return false
+	}
+	return !hasAnyTag(assertion, f.SkipTags)
+}
+
+func hasAnyTag(assertion *config.Assertion, tags []string) bool {
+	for _, t := range assertion.Tags {
+		if contains(tags, t) {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// SetFilter configures which assertions are run.
+func (e *Executor) SetFilter(f AssertionFilter) {
+	e.filter = f
+}
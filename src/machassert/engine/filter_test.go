@@ -0,0 +1,99 @@
+package engine
+
+import (
+	"machassert/config"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestAssertionFilterSelects(t *testing.T) {
+	nginx := &config.Assertion{Tags: []string{"nginx", "web"}}
+	slow := &config.Assertion{Tags: []string{"slow"}}
+	untagged := &config.Assertion{}
+
+	tcs := []struct {
+		name      string
+		filter    AssertionFilter
+		assertion *config.Assertion
+		ref       string // <spec name>.<assertion name>
+		want      bool
+	}{
+		{"no filter", AssertionFilter{}, untagged, "base.motd", true},
+		{"tag", AssertionFilter{Tags: []string{"web"}}, nginx, "base.nginx", true},
+		{"any tag", AssertionFilter{Tags: []string{"db", "nginx"}}, nginx, "base.nginx", true},
+		{"other tag", AssertionFilter{Tags: []string{"db"}}, nginx, "base.nginx", false},
+		{"untagged with tags", AssertionFilter{Tags: []string{"web"}}, untagged, "base.motd", false},
+		{"skip tag", AssertionFilter{SkipTags: []string{"slow"}}, slow, "base.backup", false},
+		{"skip other tag", AssertionFilter{SkipTags: []string{"slow"}}, nginx, "base.nginx", true},
+		{"skip wins over tag", AssertionFilter{Tags: []string{"web"}, SkipTags: []string{"nginx"}}, nginx, "base.nginx", false},
+		{"only", AssertionFilter{Only: []string{"base.nginx"}}, nginx, "base.nginx", true},
+		{"only other", AssertionFilter{Only: []string{"base.motd"}}, nginx, "base.nginx", false},
+		{"only other spec", AssertionFilter{Only: []string{"web.nginx"}}, nginx, "base.nginx", false},
+		{"only and tag", AssertionFilter{Only: []string{"base.nginx"}, Tags: []string{"db"}}, nginx, "base.nginx", false},
+	}
+	for _, tc := range tcs {
+		ref := strings.SplitN(tc.ref, ".", 2)
+		if got := tc.filter.selects(ref[0], ref[1], tc.assertion); got != tc.want {
+			t.Errorf("%s: got %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestAssertionFilterCheck(t *testing.T) {
+	specs := []*config.AssertionSpec{testSpec("base", "motd", "nginx"), testSpec("web.app", "config")}
+	tcs := []struct {
+		only []string
+		err  string
+	}{
+		{nil, ""},
+		{[]string{"base.nginx", "web.app.config"}, ""},
+		{[]string{"base.nginx", "base.missing"}, "unknown assertion: base.missing"},
+		{[]string{"nginx"}, "unknown assertion: nginx"},
+		{[]string{"web.config"}, "unknown assertion: web.config"},
+	}
+	for _, tc := range tcs {
+		f := &AssertionFilter{Only: tc.only}
+		err := f.Check(specs)
+		if (err == nil && tc.err != "") || (err != nil && err.Error() != tc.err) {
+			t.Errorf("%v: got %v, want %q", tc.only, err, tc.err)
+		}
+	}
+}
+
+// TestAssertionFilterRun checks assertions which are not selected are reported as skipped without stopping
+// assertions which depend on them, and the assertions of nested ASSERT actions are run whether or not they
+// are selected.
+func TestAssertionFilterRun(t *testing.T) {
+	spec := testSpec("base", "motd", "nginx:motd", "backup")
+	spec.Assertions["motd"].Tags = []string{"files"}
+	spec.Assertions["backup"].Tags = []string{"slow"}
+	nested := commandAssertion("base.nginx.running")
+	spec.Assertions["nginx"].Tags = []string{"web"}
+	spec.Assertions["nginx"].Actions = []*config.Action{{
+		Kind:       config.ActionAssert,
+		Assertions: map[string]*config.Assertion{"running": nested},
+	}}
+	m := &fakeMachine{name: "web", exit: map[string]int{"base.nginx": 1}}
+
+	nodes, err := buildAssertionGraph([]*config.AssertionSpec{spec})
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := New(&config.MachineSpec{}, nil)
+	e.SetLogger(quietLogger{})
+	e.SetFilter(AssertionFilter{Tags: []string{"web"}})
+	if err = e.runAssertionGraph(m, nodes); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"base.backup SKIPPED: not selected",
+		"base.motd SKIPPED: not selected",
+		"base.nginx APPLIED",
+		"base.nginx.running OK",
+	}
+	if got := resultSummary(e.Results()); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,170 @@
+package engine
+
+import "machassert/config"
+
+// assertionNode is an assertion in the dependency graph of all assertions run on a machine.
+type assertionNode struct {
+	spec      *config.AssertionSpec
+	name      string
+	assertion *config.Assertion
+	deps      []int // indexes of the nodes which must hold before this node is run
+}
+
+// Node states while running the graph.
+const (
+	nodePending = iota
+	nodeRunning
+	nodeOK      // held, or failed with ignore_errors
+	nodeFailed  // failed, but later assertions are still run
+	nodeStopped // failed with on_failure = "stop", or skipped because such an assertion failed
+	nodeSkipped // skipped, as an assertion it depends on failed
+)
+
+// buildAssertionGraph orders the assertions of the specs so every node comes after its dependencies.
+// Otherwise, assertions keep the order of their spec and then their Order field. Assertions without
+// depends_on depend on every assertion before them, so behave as if the assertions were run sequentially.
+// Unknown references & cycles are reported by config.CheckDependencies.
+func buildAssertionGraph(specs []*config.AssertionSpec) ([]*assertionNode, error) {
+	if err := config.CheckDependencies(specs); err != nil {
+		return nil, err
+	}
+
+	var all []*assertionNode
+	index := map[*config.Assertion]int{}
+	for _, spec := range specs {
+		for _, name := range sortAssertions(spec.Assertions) {
+			index[spec.Assertions[name]] = len(all)
+			all = append(all, &assertionNode{spec: spec, name: name, assertion: spec.Assertions[name]})
+		}
+	}
+
+	explicit := make([][]int, len(all))
+	for i, n := range all {
+		for _, ref := range n.assertion.DependsOn {
+			spec, name, _ := config.ResolveDependency(specs, n.spec, ref)
+			explicit[i] = append(explicit[i], index[spec.Assertions[name]])
+		}
+	}
+
+	// Repeatedly take the first node (in spec order) whose dependencies have all been taken. As there
+	// are no cycles, there is always such a node.
+	pos := make([]int, len(all))
+	placed := make([]bool, len(all))
+	var out []*assertionNode
+	for len(out) < len(all) {
+		next := 0
+		for placed[next] || !allPlaced(explicit[next], placed) {
+			next++
+		}
+
+		n := all[next]
+		if n.assertion.DependsOn == nil {
+			for j := range out {
+				n.deps = append(n.deps, j)
+			}
+		} else {
+			for _, dep := range explicit[next] {
+				n.deps = append(n.deps, pos[dep])
+			}
+		}
+		pos[next] = len(out)
+		placed[next] = true
+		out = append(out, n)
+	}
+	return out, nil
+}
+
+func allPlaced(deps []int, placed []bool) bool {
+	for _, d := range deps {
+		if !placed[d] {
+			return false
+		}
+	}
+	return true
+}
+
+// runAssertionGraph runs the assertions on the machine, starting each once its dependencies are done, with up
+// to maxAssertionParallelism() running at once. Nodes with depends_on are skipped if any of their dependencies
+// did not hold. Once a node fails with on_failure = "stop", no further nodes are started; nodes which are
+// already running are waited for. The error of the first node which failed (and did not have ignore_errors
+// set) is returned.
+func (e *Executor) runAssertionGraph(machine Machine, nodes []*assertionNode) error {
+	state := make([]int, len(nodes))
+	errs := make([]error, len(nodes))
+	done := make(chan int)
+	running := 0
+	stopped := false
+
+	for {
+		for i, n := range nodes {
+			if state[i] != nodePending {
+				continue
+			}
+			if stopped {
+				e.skipAssertion(machine, n.spec.Name, n.name, n.assertion, "an earlier assertion failed")
+				state[i] = nodeStopped
+				continue
+			}
+			if !e.filter.selects(n.spec.Name, n.name, n.assertion) {
+				e.skipAssertion(machine, n.spec.Name, n.name, n.assertion, "not selected")
+				state[i] = nodeOK
+				continue
+			}
+			state[i] = nextNodeState(n, state)
+			if state[i] == nodeSkipped {
+				e.skipAssertion(machine, n.spec.Name, n.name, n.assertion, "an assertion it depends on failed")
+			}
+			if state[i] == nodeRunning {
+				if running >= e.maxAssertionParallelism() {
+					state[i] = nodePending
+					continue
+				}
+				running++
+				go func(i int, n *assertionNode) {
+					errs[i] = e.runAssertion(machine, n.spec.Name, n.name, n.assertion)
+					done <- i
+				}(i, n)
+			}
+		}
+
+		if running == 0 {
+			break
+		}
+		i := <-done
+		running--
+		switch {
+		case errs[i] == nil || nodes[i].assertion.IgnoreErrors:
+			errs[i] = nil
+			state[i] = nodeOK
+		case e.continueAfter(nodes[i].assertion):
+			state[i] = nodeFailed
+		default:
+			state[i] = nodeStopped
+			stopped = true
+		}
+	}
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nextNodeState returns nodeRunning if the pending node can be started, nodePending if it must wait
+// for its dependencies, or nodeSkipped if it has depends_on and a dependency did not hold.
+func nextNodeState(n *assertionNode, state []int) int {
+	out := nodeRunning
+	for _, d := range n.deps {
+		switch state[d] {
+		case nodePending, nodeRunning:
+			return nodePending
+		case nodeFailed, nodeStopped, nodeSkipped:
+			if n.assertion.DependsOn != nil {
+				out = nodeSkipped
+			}
+		}
+	}
+	return out
+}
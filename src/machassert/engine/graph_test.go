@@ -0,0 +1,303 @@
+package engine
+
+import (
+	"machassert/config"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testSpec returns a spec with an assertion for each "name:dep,dep" entry. An entry with a trailing colon
+// has an empty depends_on, and an entry without a colon has no depends_on. Assertions are ordered as given,
+// and are command assertions (see commandAssertion) running a command named after the assertion.
+func testSpec(name string, assertions ...string) *config.AssertionSpec {
+	spec := &config.AssertionSpec{Name: name, Assertions: map[string]*config.Assertion{}}
+	for i, a := range assertions {
+		parts := strings.SplitN(a, ":", 2)
+		assertion := commandAssertion(name + "." + parts[0])
+		assertion.Order = i + 1
+		if len(parts) == 2 {
+			assertion.DependsOn = []string{}
+			if parts[1] != "" {
+				assertion.DependsOn = strings.Split(parts[1], ",")
+			}
+		}
+		spec.Assertions[parts[0]] = assertion
+	}
+	return spec
+}
+
+func TestBuildAssertionGraph(t *testing.T) {
+	tcs := []struct {
+		name  string
+		specs []*config.AssertionSpec
+		order []string // qualified names of the nodes, in order
+		deps  [][]int
+	}{
+		{
+			name:  "sequential",
+			specs: []*config.AssertionSpec{testSpec("s", "a", "b", "c")},
+			order: []string{"s.a", "s.b", "s.c"},
+			deps:  [][]int{nil, {0}, {0, 1}},
+		},
+		{
+			name:  "across specs",
+			specs: []*config.AssertionSpec{testSpec("s", "a"), testSpec("t", "b")},
+			order: []string{"s.a", "t.b"},
+			deps:  [][]int{nil, {0}},
+		},
+		{
+			name:  "empty depends_on",
+			specs: []*config.AssertionSpec{testSpec("s", "a", "b:", "c")},
+			order: []string{"s.a", "s.b", "s.c"},
+			deps:  [][]int{nil, nil, {0, 1}},
+		},
+		{
+			name:  "dependency moved earlier",
+			specs: []*config.AssertionSpec{testSpec("s", "a:c", "b:", "c:")},
+			order: []string{"s.b", "s.c", "s.a"},
+			deps:  [][]int{nil, nil, {1}},
+		},
+		{
+			name:  "qualified reference",
+			specs: []*config.AssertionSpec{testSpec("s", "a:t.b"), testSpec("t", "b:")},
+			order: []string{"t.b", "s.a"},
+			deps:  [][]int{nil, {0}},
+		},
+		{
+			name:  "diamond",
+			specs: []*config.AssertionSpec{testSpec("s", "d:b,c", "b:a", "c:a", "a:")},
+			order: []string{"s.a", "s.b", "s.c", "s.d"},
+			deps:  [][]int{nil, {0}, {0}, {1, 2}},
+		},
+	}
+
+	for _, tc := range tcs {
+		nodes, err := buildAssertionGraph(tc.specs)
+		if err != nil {
+			t.Errorf("%s: %v", tc.name, err)
+			continue
+		}
+		var order []string
+		var deps [][]int
+		for _, n := range nodes {
+			order = append(order, n.spec.Name+"."+n.name)
+			deps = append(deps, n.deps)
+		}
+		if !reflect.DeepEqual(order, tc.order) {
+			t.Errorf("%s: got order %v, want %v", tc.name, order, tc.order)
+		}
+		if !reflect.DeepEqual(deps, tc.deps) {
+			t.Errorf("%s: got deps %v, want %v", tc.name, deps, tc.deps)
+		}
+	}
+}
+
+func TestBuildAssertionGraphErrors(t *testing.T) {
+	tcs := []struct {
+		name  string
+		specs []*config.AssertionSpec
+		err   string
+	}{
+		{
+			name:  "unknown",
+			specs: []*config.AssertionSpec{testSpec("s", "a:missing")},
+			err:   "s.a: depends_on references unknown assertion: missing",
+		},
+		{
+			name:  "cycle",
+			specs: []*config.AssertionSpec{testSpec("s", "a:b", "b:a")},
+			err:   "depends_on references form a cycle: s.a -> s.b -> s.a",
+		},
+	}
+
+	for _, tc := range tcs {
+		_, err := buildAssertionGraph(tc.specs)
+		if err == nil || err.Error() != tc.err {
+			t.Errorf("%s: got %v, want %q", tc.name, err, tc.err)
+		}
+	}
+}
+
+func TestNextNodeState(t *testing.T) {
+	tcs := []struct {
+		name      string
+		dependsOn []string
+		deps      []int
+		state     []int
+		want      int
+	}{
+		{"no deps", nil, nil, nil, nodeRunning},
+		{"deps done", nil, []int{0, 1}, []int{nodeOK, nodeOK}, nodeRunning},
+		{"dep pending", nil, []int{0, 1}, []int{nodeOK, nodePending}, nodePending},
+		{"dep running", []string{"a"}, []int{0}, []int{nodeRunning}, nodePending},
+		{"dep failed", nil, []int{0}, []int{nodeFailed}, nodeRunning},
+		{"dep skipped", nil, []int{0}, []int{nodeSkipped}, nodeRunning},
+		{"explicit dep failed", []string{"a"}, []int{0}, []int{nodeFailed}, nodeSkipped},
+		{"explicit dep stopped", []string{"a"}, []int{0}, []int{nodeStopped}, nodeSkipped},
+		{"explicit dep skipped", []string{"a", "b"}, []int{0, 1}, []int{nodeOK, nodeSkipped}, nodeSkipped},
+		{"explicit dep failed, other pending", []string{"a", "b"}, []int{0, 1}, []int{nodeFailed, nodePending}, nodePending},
+	}
+
+	for _, tc := range tcs {
+		n := &assertionNode{assertion: &config.Assertion{DependsOn: tc.dependsOn}, deps: tc.deps}
+		if got := nextNodeState(n, tc.state); got != tc.want {
+			t.Errorf("%s: got %d, want %d", tc.name, got, tc.want)
+		}
+	}
+}
+
+// quietLogger is a Logger which discards the status of assertions, for tests which only run assertions on
+// a machine rather than the whole executor.
+type quietLogger struct {
+	Logger
+}
+
+func (quietLogger) LogAssertionStatus(string, string, string, *config.Assertion, *AssertionResult, error) {
+}
+
+func TestRunAssertionGraph(t *testing.T) {
+	tcs := []struct {
+		name       string
+		specs      []*config.AssertionSpec
+		fail       []string // assertions which fail
+		continueOn []string // assertions with on_failure = "continue"
+		ignore     []string // assertions with ignore_errors set
+		want       []string // the result of each assertion, in the order of the graph
+		wantErr    bool
+	}{
+		{
+			name:  "all hold",
+			specs: []*config.AssertionSpec{testSpec("s", "a", "b"), testSpec("t", "c:")},
+			want:  []string{"s.a OK", "s.b OK", "t.c OK"},
+		},
+		{
+			name:    "stop",
+			specs:   []*config.AssertionSpec{testSpec("s", "a", "b", "c", "d:c")},
+			fail:    []string{"s.b"},
+			want:    []string{"s.a OK", "s.b FAILED", "s.c SKIPPED: an earlier assertion failed", "s.d SKIPPED: an earlier assertion failed"},
+			wantErr: true,
+		},
+		{
+			name:       "continue",
+			specs:      []*config.AssertionSpec{testSpec("s", "a", "b", "c")},
+			fail:       []string{"s.b"},
+			continueOn: []string{"s.b"},
+			want:       []string{"s.a OK", "s.b FAILED", "s.c OK"},
+			wantErr:    true,
+		},
+		{
+			name:       "skip dependents",
+			specs:      []*config.AssertionSpec{testSpec("s", "a:", "b:a", "c:b", "d:", "e")},
+			fail:       []string{"s.a"},
+			continueOn: []string{"s.a"},
+			want: []string{
+				"s.a FAILED",
+				"s.b SKIPPED: an assertion it depends on failed",
+				"s.c SKIPPED: an assertion it depends on failed",
+				"s.d OK",
+				"s.e OK",
+			},
+			wantErr: true,
+		},
+		{
+			name:   "ignore_errors",
+			specs:  []*config.AssertionSpec{testSpec("s", "a:", "b:a")},
+			fail:   []string{"s.a"},
+			ignore: []string{"s.a"},
+			want:   []string{"s.a FAILED", "s.b OK"},
+		},
+	}
+
+	for _, tc := range tcs {
+		m := &fakeMachine{name: "web", exit: map[string]int{}}
+		for _, spec := range tc.specs {
+			for name, assertion := range spec.Assertions {
+				ref := spec.Name + "." + name
+				if contains(tc.fail, ref) {
+					m.exit[ref] = 1
+				}
+				if contains(tc.continueOn, ref) {
+					assertion.OnFailure = config.OnFailureContinue
+				}
+				assertion.IgnoreErrors = contains(tc.ignore, ref)
+			}
+		}
+		nodes, err := buildAssertionGraph(tc.specs)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+
+		e := New(&config.MachineSpec{}, tc.specs)
+		e.SetLogger(quietLogger{})
+		e.SetAssertionParallelism(4)
+		err = e.runAssertionGraph(m, nodes)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("%s: got error %v, want error: %v", tc.name, err, tc.wantErr)
+		}
+		if got := resultSummary(e.Results()); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%s: got %q, want %q", tc.name, got, tc.want)
+		}
+	}
+}
+
+// resultSummary returns "<assertion> <result>[: <skip reason>]" for each result, sorted by assertion.
+func resultSummary(results []*Result) []string {
+	var out []string
+	for _, r := range results {
+		s := r.Assertion + " " + r.Result.String()
+		if r.Skipped() {
+			s += ": " + r.SkipReason
+		}
+		out = append(out, s)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func TestRunAssertionGraphParallelism(t *testing.T) {
+	independent := testSpec("s", "a:", "b:", "c:", "d:", "e:a,b,c,d")
+	tcs := []struct {
+		name        string
+		spec        *config.AssertionSpec
+		parallelism int
+		want        int // the most assertions run at once
+	}{
+		{"default", independent, 0, 1},
+		{"two", independent, 2, 2},
+		{"more than independent assertions", independent, 10, 4},
+		{"without depends_on", testSpec("s", "a", "b", "c"), 10, 1},
+	}
+
+	for _, tc := range tcs {
+		m := &fakeMachine{name: "web", delay: 10 * time.Millisecond}
+		nodes, err := buildAssertionGraph([]*config.AssertionSpec{tc.spec})
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		e := New(&config.MachineSpec{}, nil)
+		e.SetLogger(quietLogger{})
+		e.SetAssertionParallelism(tc.parallelism)
+		if err = e.runAssertionGraph(m, nodes); err != nil {
+			t.Errorf("%s: %v", tc.name, err)
+		}
+		if m.maxRunning != tc.want {
+			t.Errorf("%s: %d assertions ran at once, want %d", tc.name, m.maxRunning, tc.want)
+		}
+
+		results := e.Results()
+		if len(results) != len(tc.spec.Assertions) {
+			t.Fatalf("%s: got %d results, want %d", tc.name, len(results), len(tc.spec.Assertions))
+		}
+		if tc.spec == independent {
+			last := results[len(results)-1]
+			for _, r := range results[:len(results)-1] {
+				if last.Assertion != "s.e" || last.Start.Before(r.Start.Add(r.Duration)) {
+					t.Errorf("%s: s.e started before %s finished", tc.name, r.Assertion)
+				}
+			}
+		}
+	}
+}
@@ -2,6 +2,8 @@ package engine
 
 import (
 	"io"
+	"machassert/machine"
+	"os"
 )
 
 // Machine represents a target for assertions. The base type implements the communication layer to the target.
@@ -10,6 +12,15 @@ type Machine interface {
 	ReadFile(fpath string) (io.ReadCloser, error)
 	WriteFile(fpath string) (io.WriteCloser, error)
 	Grep(fpath, regex string) (bool, error)
-	Hash(fpath string) ([]byte, error)
+	Hash(fpath, algorithm string) ([]byte, error)
+	Chmod(fpath string, mode os.FileMode) error
+	Run(name string, args []string) ([]byte, error)
+	Exec(cmd *machine.Command) (*machine.CommandResult, error)
+	// Stat returns information about a file, without following symlinks.
+	Stat(fpath string) (*machine.FileInfo, error)
+	Mkdir(fpath string, mode os.FileMode, parents bool) error
+	Remove(fpath string, recursive bool) error
+	Symlink(target, fpath string) error
+	Chown(fpath, owner, group string) error
 	Close() error
 }
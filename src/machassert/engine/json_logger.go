@@ -0,0 +1,187 @@
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"machassert/config"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/howeyc/gopass"
+)
+
+// JSONLogger implements the Logger interface by writing one JSON object per line for each event,
+// for consumption by other programs. Prompts for credentials are written to stderr.
+type JSONLogger struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+
+	machineStarts   map[string]time.Time
+	assertionStarts map[assertionKey]time.Time
+	counts          map[string]map[string]int
+}
+
+type assertionKey struct {
+	machine   string
+	assertion *config.Assertion
+}
+
+// jsonEvent is a line written by JSONLogger. Fields which do not apply to the event are omitted.
+type jsonEvent struct {
+	Time       time.Time        `json:"time"`
+	Event      string           `json:"event"` // machine, assertion_start, assertion_finish or summary
+	Machine    string           `json:"machine"`
+	Status     string           `json:"status,omitempty"` // machine: connecting, connected or error
+	Spec       string           `json:"spec,omitempty"`
+	Assertion  string           `json:"assertion,omitempty"`
+	Kind       string           `json:"kind,omitempty"`
+	Result     string           `json:"result,omitempty"`
+	Ignored    bool             `json:"ignored,omitempty"`
+	Error      string           `json:"error,omitempty"`
+	DurationMs *int64           `json:"duration_ms,omitempty"`
+	Actions    []*jsonAction    `json:"actions,omitempty"`
+	Output     []*CommandOutput `json:"output,omitempty"`
+	Counts     map[string]int   `json:"counts,omitempty"` // summary: number of assertions with each result
+}
+
+type jsonAction struct {
+	Kind        string `json:"kind"`
+	Description string `json:"description"`
+}
+
+// NewJSONLogger returns a logger which writes JSON lines to w.
+func NewJSONLogger(w io.Writer) *JSONLogger {
+	return &JSONLogger{
+		enc:             json.NewEncoder(w),
+		machineStarts:   map[string]time.Time{},
+		assertionStarts: map[assertionKey]time.Time{},
+		counts:          map[string]map[string]int{},
+	}
+}
+
+// write encodes the event. l.mu must be held.
+func (l *JSONLogger) write(ev *jsonEvent) {
+	ev.Time = time.Now().UTC()
+	l.enc.Encode(ev)
+}
+
+// LogMachineStatus is called when a machine's (being asserted against) status changes.
+func (l *JSONLogger) LogMachineStatus(name string, isConnected bool, m *config.Machine, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	ev := &jsonEvent{Event: "machine", Machine: name, Status: "connecting"}
+	if !isConnected && err == nil {
+		l.machineStarts[name] = time.Now()
+		l.counts[name] = map[string]int{}
+	} else {
+		ev.Status = "connected"
+		ev.DurationMs = millisSince(l.machineStarts[name])
+		if err != nil {
+			ev.Status = "error"
+			ev.Error = err.Error()
+		}
+	}
+	l.write(ev)
+}
+
+// LogAssertionStatus is called with assertion information when an assertion changes status.
+func (l *JSONLogger) LogAssertionStatus(machineName, specName, assertionName string, assertion *config.Assertion,
+	assertionResult *AssertionResult, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	key := assertionKey{machineName, assertion}
+	ev := &jsonEvent{
+		Event:     "assertion_start",
+		Machine:   machineName,
+		Spec:      specName,
+		Assertion: assertionName,
+		Kind:      assertion.Kind,
+	}
+	if assertionResult == nil {
+		l.assertionStarts[key] = time.Now()
+		l.write(ev)
+		return
+	}
+
+	ev.Event = "assertion_finish"
+	ev.Result = assertionResult.String()
+	if start, ok := l.assertionStarts[key]; ok {
+		ev.DurationMs = millisSince(start)
+		delete(l.assertionStarts, key)
+	}
+	if err != nil {
+		ev.Error = err.Error()
+		ev.Ignored = assertion.IgnoreErrors
+	}
+	for _, action := range assertionResult.Actions {
+		ev.Actions = append(ev.Actions, &jsonAction{Kind: action.Kind, Description: describeAction(action)})
+	}
+	if assertionResult.Result != AssertionNoop && assertionResult.Result != AssertionApplied {
+		ev.Output = assertionResult.Output
+	}
+	if ev.Ignored {
+		l.counts[machineName]["IGNORED"]++
+	} else {
+		l.counts[machineName][ev.Result]++
+	}
+	l.write(ev)
+}
+
+// LogRunSummary writes a summary event for each machine, with the number of assertions with each result.
+func (l *JSONLogger) LogRunSummary() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, name := range sortedKeys(l.counts) {
+		l.write(&jsonEvent{Event: "summary", Machine: name, Counts: l.counts[name]})
+	}
+}
+
+func millisSince(t time.Time) *int64 {
+	ms := time.Since(t).Nanoseconds() / int64(time.Millisecond)
+	return &ms
+}
+
+func sortedKeys(m map[string]map[string]int) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// AuthenticationPrompt is called by a machine object if authKind = 'prompt', and a password is required.
+func (l *JSONLogger) AuthenticationPrompt(prompt string) (string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	fmt.Fprint(os.Stderr, prompt)
+	pw, err := gopass.GetPasswd()
+	return string(pw), err
+}
+
+// KeyboardInteractiveAuth is called by a machine object if authKind = 'prompt', and a keyboard interactive authentication session is initiated by the server.
+func (l *JSONLogger) KeyboardInteractiveAuth(user, instruction string, questions []string, echos []bool) ([]string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if instruction != "" {
+		fmt.Fprintf(os.Stderr, "%s (%s)\n", instruction, user)
+	}
+	var answers []string
+	for _, q := range questions {
+		fmt.Fprintf(os.Stderr, "\t%s", q)
+		r, err := gopass.GetPasswd()
+		if err != nil {
+			return nil, err
+		}
+		answers = append(answers, string(r))
+	}
+	return answers, nil
+}
@@ -0,0 +1,99 @@
+package engine
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"machassert/config"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestJSONLogger(t *testing.T) {
+	var out bytes.Buffer
+	l := NewJSONLogger(&out)
+	web := &config.Machine{Kind: config.KindSSH}
+	motd := &config.Assertion{Kind: config.FileExistsAssrt}
+	nginx := &config.Assertion{Kind: config.CommandAssrt}
+	cache := &config.Assertion{Kind: config.CommandAssrt, IgnoreErrors: true}
+	app := &config.Assertion{Kind: config.ServiceRunningAssrt}
+	output := []*CommandOutput{{Command: "systemctl is-active nginx", ExitStatus: 3, Stdout: "inactive\n"}}
+
+	l.LogMachineStatus("web-1", false, web, nil)
+	l.LogMachineStatus("db-1", false, web, nil)
+	l.LogMachineStatus("web-1", true, web, nil)
+	l.LogAssertionStatus("web-1", "base", "motd", motd, nil, nil)
+	l.LogAssertionStatus("web-1", "base", "motd", motd, &AssertionResult{
+		Result:  AssertionApplied,
+		Actions: []*config.Action{{Kind: config.ActionCopyFile, SourcePath: "motd", DestinationPath: "/etc/motd"}},
+		Output:  output,
+	}, nil)
+	l.LogMachineStatus("db-1", true, web, errors.New("connection refused"))
+	l.LogAssertionStatus("web-1", "base", "nginx", nginx, nil, nil)
+	l.LogAssertionStatus("web-1", "base", "nginx", nginx, &AssertionResult{
+		Result:  AssertionFailed,
+		Actions: []*config.Action{{Kind: config.ActionFail}},
+		Output:  output,
+	}, ErrAssertionsFailed)
+	l.LogAssertionStatus("web-1", "base", "cache", cache, nil, nil)
+	l.LogAssertionStatus("web-1", "base", "cache", cache, &AssertionResult{Result: AssertionError}, errors.New("exit status 1"))
+	l.LogAssertionStatus("web-1", "base", "app", app, &AssertionResult{Result: AssertionSkipped}, nil)
+	l.LogRunSummary()
+
+	want := []string{
+		`{"event":"machine","machine":"web-1","status":"connecting"}`,
+		`{"event":"machine","machine":"db-1","status":"connecting"}`,
+		`{"duration_ms":0,"event":"machine","machine":"web-1","status":"connected"}`,
+		`{"assertion":"motd","event":"assertion_start","kind":"exists","machine":"web-1","spec":"base"}`,
+		`{"actions":[{"description":"COPY motd -> /etc/motd","kind":"COPY"}],"assertion":"motd","duration_ms":0,"event":"assertion_finish","kind":"exists","machine":"web-1","result":"APPLIED","spec":"base"}`,
+		`{"duration_ms":0,"error":"connection refused","event":"machine","machine":"db-1","status":"error"}`,
+		`{"assertion":"nginx","event":"assertion_start","kind":"command","machine":"web-1","spec":"base"}`,
+		`{"actions":[{"description":"FAIL","kind":"FAIL"}],"assertion":"nginx","duration_ms":0,"error":"assertions failed","event":"assertion_finish","kind":"command","machine":"web-1","output":[{"command":"systemctl is-active nginx","exit_status":3,"stderr":"","stdout":"inactive\n"}],"result":"FAILED","spec":"base"}`,
+		`{"assertion":"cache","event":"assertion_start","kind":"command","machine":"web-1","spec":"base"}`,
+		`{"assertion":"cache","duration_ms":0,"error":"exit status 1","event":"assertion_finish","ignored":true,"kind":"command","machine":"web-1","result":"ERR","spec":"base"}`,
+		`{"assertion":"app","event":"assertion_finish","kind":"service_running","machine":"web-1","result":"SKIPPED","spec":"base"}`,
+		`{"event":"summary","machine":"db-1"}`,
+		`{"counts":{"APPLIED":1,"FAILED":1,"IGNORED":1,"SKIPPED":1},"event":"summary","machine":"web-1"}`,
+	}
+
+	var got []string
+	var line bytes.Buffer
+	enc := json.NewEncoder(&line)
+	enc.SetEscapeHTML(false)
+	scanner := bufio.NewScanner(bytes.NewReader(out.Bytes()))
+	for scanner.Scan() {
+		var ev map[string]interface{}
+		if err := json.Unmarshal(scanner.Bytes(), &ev); err != nil {
+			t.Fatalf("invalid JSON line %q: %v", scanner.Text(), err)
+		}
+		// Times and durations vary, so only check they are present and valid.
+		if ts, _ := ev["time"].(string); ts == "" {
+			t.Errorf("missing time: %s", scanner.Text())
+		} else if _, err := time.Parse(time.RFC3339Nano, ts); err != nil {
+			t.Errorf("invalid time: %v", err)
+		}
+		delete(ev, "time")
+		if d, ok := ev["duration_ms"]; ok {
+			if ms, _ := d.(float64); ms < 0 {
+				t.Errorf("negative duration: %s", scanner.Text())
+			}
+			ev["duration_ms"] = 0
+		}
+		line.Reset()
+		if err := enc.Encode(ev); err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, strings.TrimSuffix(line.String(), "\n"))
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("got %d events, want %d:\n%s", len(got), len(want), out.String())
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("event %d: got\n%s\nwant\n%s", i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package engine
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+type junitTestSuites struct {
+	XMLName  xml.Name          `xml:"testsuites"`
+	Tests    int               `xml:"tests,attr"`
+	Failures int               `xml:"failures,attr"`
+	Errors   int               `xml:"errors,attr"`
+	Skipped  int               `xml:"skipped,attr"`
+	Suites   []*junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string           `xml:"name,attr"`
+	Tests     int              `xml:"tests,attr"`
+	Failures  int              `xml:"failures,attr"`
+	Errors    int              `xml:"errors,attr"`
+	Skipped   int              `xml:"skipped,attr"`
+	Time      string           `xml:"time,attr"`
+	Timestamp string           `xml:"timestamp,attr,omitempty"`
+	Cases     []*junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+	SystemOut *junitOutput  `xml:"system-out,omitempty"`
+}
+
+type junitOutput struct {
+	Text string `xml:",cdata"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr,omitempty"`
+	Type    string `xml:"type,attr,omitempty"`
+}
+
+// writeJUnit writes a JUnit XML report. Assertions which failed are failures, those which errored (or
+// whose actions errored) are errors, and those which were not run or have ignore_errors set are skipped.
+// An assertion which only failed because of a nested assertion passes; the nested assertion is the failure.
+// A machine which could not be asserted on has a test case named after the machine, with the error.
+func writeJUnit(w io.Writer, machines []*machineReport) error {
+	out := &junitTestSuites{}
+	for _, m := range machines {
+		suite := &junitTestSuite{Name: m.name, Time: junitTime(m.elapsed())}
+		if len(m.results) > 0 {
+			suite.Timestamp = m.results[0].Start.UTC().Format("2006-01-02T15:04:05")
+		}
+		if m.err != nil {
+			suite.Cases = append(suite.Cases, &junitTestCase{
+				Name:      m.name,
+				Classname: m.name,
+				Time:      junitTime(0),
+				Error:     &junitMessage{Message: m.err.Error()},
+			})
+			suite.Errors++
+		}
+
+		for _, r := range m.results {
+			c := &junitTestCase{Name: r.Assertion, Classname: m.name, Time: junitTime(r.Duration)}
+			switch {
+			case r.Skipped():
+				c.Skipped = &junitMessage{Message: "not run: " + r.SkipReason}
+				suite.Skipped++
+			case failedInNested(r):
+			case r.Err != nil && r.Ignored:
+				c.Skipped = &junitMessage{Message: "ignored: " + failureMessage(r), Type: r.Result.String()}
+				suite.Skipped++
+			case r.Result.Result == AssertionFailed:
+				c.Failure = &junitMessage{Message: failureMessage(r), Type: r.Result.String()}
+				suite.Failures++
+			case r.Err != nil || r.Result.IsError():
+				c.Error = &junitMessage{Message: failureMessage(r), Type: r.Result.String()}
+				suite.Errors++
+			}
+			if r.Err != nil && !failedInNested(r) {
+				if output := formatOutput(r.Result.Output); output != "" {
+					c.SystemOut = &junitOutput{Text: xmlText(output)}
+				}
+			}
+			suite.Cases = append(suite.Cases, c)
+		}
+
+		suite.Tests = len(suite.Cases)
+		out.Tests += suite.Tests
+		out.Failures += suite.Failures
+		out.Errors += suite.Errors
+		out.Skipped += suite.Skipped
+		out.Suites = append(out.Suites, suite)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(out); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// junitTime formats a duration as seconds.
+func junitTime(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
+
+// xmlText replaces the characters which cannot appear in an XML 1.0 document, such as the escape sequences
+// of colored command output, with U+FFFD. encoding/xml only does so for text it escapes itself, not CDATA.
+func xmlText(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r == '\t' || r == '\n' || r == '\r':
+		case r < 0x20, r >= 0xd800 && r <= 0xdfff, r == 0xfffe || r == 0xffff:
+			return utf8.RuneError
+		}
+		return r
+	}, strings.ToValidUTF8(s, string(utf8.RuneError)))
+}
@@ -3,16 +3,23 @@ package engine
 import (
 	"fmt"
 	"machassert/config"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/howeyc/gopass"
 )
 
-// Logger is how the status of assertions and runs are communicated.
+// Logger is how the status of assertions and runs are communicated. Implementations must be safe
+// for concurrent use, as machines may be asserted on in parallel.
 type Logger interface {
 	LogMachineStatus(string, bool, *config.Machine, error)
-	LogAssertionStatus(string, string, *config.Assertion, *AssertionResult, error)
+	// LogAssertionStatus is called with the machine name, spec name & assertion name when an assertion starts (result == nil) and finishes.
+	// Assertions which are skipped only finish, with a result of AssertionSkipped.
+	LogAssertionStatus(string, string, string, *config.Assertion, *AssertionResult, error)
+	// LogRunSummary is called once all machines have finished.
+	LogRunSummary()
 	// AuthenticationPrompt is called by the machine if a password is required and auth.Kind = prompt
 	AuthenticationPrompt(prompt string) (string, error)
 	// KeyboardInteractiveAuth is called by the machine if prompts recieved and auth.Kind = prompt
@@ -20,25 +27,31 @@ type Logger interface {
 }
 
 // ConsoleLogger implementes the Logger interface by pretty-printing to the terminal.
+// Each machine is painted as its own section, in the order the machines were first seen.
 type ConsoleLogger struct {
-	machines       map[string]machineStatus
-	assertionInfo  []*assertionInfo
-	currentMachine string
-	linesPrinted   int
+	mu sync.Mutex
+	runStatus
+	linesPrinted int
 
 	haveDoneInteractivePrompt bool
 }
 
+// runStatus tracks the status of every machine and assertion, in the order the machines were first seen.
+type runStatus struct {
+	machines     map[string]*machineStatus
+	machineOrder []string
+}
+
 type machineStatus struct {
 	machine     *config.Machine
 	err         error
 	isConnected bool
+	assertions  []*assertionInfo
 }
 
 type assertionInfo struct {
 	name      string
 	specName  string
-	machine   string
 	assertion *config.Assertion
 	result    *AssertionResult
 	err       error
@@ -46,6 +59,9 @@ type assertionInfo struct {
 
 // KeyboardInteractiveAuth is called by a machine object if authKind = 'prompt', and a keyboard interactive authentication session is initiated by the server.
 func (l *ConsoleLogger) KeyboardInteractiveAuth(user, instruction string, questions []string, echos []bool) (answers []string, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
 	if instruction != "" {
 		l.printf("\n%s (%s)", instruction, user)
 	}
@@ -67,6 +83,9 @@ func (l *ConsoleLogger) KeyboardInteractiveAuth(user, instruction string, questi
 
 // AuthenticationPrompt is called by a machine object if authKind = 'prompt', and a password is required.
 func (l *ConsoleLogger) AuthenticationPrompt(prompt string) (string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
 	l.printf("\n%s", prompt)
 	pw, err := gopass.GetPasswd()
 	l.linesPrinted++
@@ -75,18 +94,60 @@ func (l *ConsoleLogger) AuthenticationPrompt(prompt string) (string, error) {
 
 // LogMachineStatus is called when a machine's (being asserted against) status changes.
 func (l *ConsoleLogger) LogMachineStatus(name string, isConnected bool, m *config.Machine, err error) {
-	if l.machines == nil {
-		l.machines = make(map[string]machineStatus)
-	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
 
-	l.machines[name] = machineStatus{
-		machine:     m,
-		isConnected: isConnected,
-		err:         err,
+	status := l.machineStatus(name)
+	status.machine = m
+	status.isConnected = isConnected
+	status.err = err
+	l.paint()
+}
+
+// machineStatus returns the status for the named machine, creating it if necessary.
+func (s *runStatus) machineStatus(name string) *machineStatus {
+	if s.machines == nil {
+		s.machines = make(map[string]*machineStatus)
 	}
-	l.currentMachine = name
+	status, ok := s.machines[name]
+	if !ok {
+		status = &machineStatus{}
+		s.machines[name] = status
+		s.machineOrder = append(s.machineOrder, name)
+	}
+	return status
+}
 
-	l.paint()
+// setAssertionStatus records that an assertion started (result == nil) or finished.
+func (s *runStatus) setAssertionStatus(machineName, specName, assertionName string, assertion *config.Assertion,
+	assertionResult *AssertionResult, err error) {
+	status := s.machineStatus(machineName)
+	if assertionResult == nil {
+		status.assertions = append(status.assertions, &assertionInfo{
+			assertion: assertion,
+			name:      assertionName,
+			specName:  specName,
+		})
+		return
+	}
+	started := false
+	for i := range status.assertions {
+		if status.assertions[i].assertion == assertion {
+			status.assertions[i].result = assertionResult
+			status.assertions[i].err = err
+			started = true
+		}
+	}
+	if started {
+		return
+	}
+	status.assertions = append(status.assertions, &assertionInfo{
+		assertion: assertion,
+		name:      assertionName,
+		specName:  specName,
+		result:    assertionResult,
+		err:       err,
+	})
 }
 
 func sanitizeName(in string) string {
@@ -99,68 +160,162 @@ func (l *ConsoleLogger) printf(format string, v ...interface{}) {
 	fmt.Print(out)
 }
 
-func (l *ConsoleLogger) paint() {
-	if l.currentMachine == "" {
-		return
+func colorResult(result *AssertionResult) string {
+	switch result.Result {
+	case AssertionNoop:
+		return Green(result.String())
+	case AssertionApplied:
+		return Yellow(result.String())
+	case AssertionSkipped:
+		return Color(result.String(), Dim)
+	default:
+		return Red(result.String())
 	}
+}
 
+// paint redraws the status of every machine. l.mu must be held.
+func (l *ConsoleLogger) paint() {
 	// clear lines printed
 	fmt.Print(escStart + strconv.Itoa(l.linesPrinted) + "F" + escStart + "0J")
 	l.linesPrinted = 0
 
-	if l.machines[l.currentMachine].isConnected && l.machines[l.currentMachine].err == nil {
-		l.printf("Running assertions on %s: %s\n", Cyan(l.currentMachine), Green("CONNECTED"))
-	} else if l.machines[l.currentMachine].err == nil {
-		l.printf("Connecting to %s:", Cyan(l.currentMachine))
+	for _, name := range l.machineOrder {
+		l.paintMachine(name, l.machines[name])
+	}
+}
+
+func (l *ConsoleLogger) paintMachine(name string, status *machineStatus) {
+	if status.isConnected && status.err == nil {
+		l.printf("Running assertions on %s: %s\n", Cyan(name), Green("CONNECTED"))
+	} else if status.err == nil {
+		l.printf("Connecting to %s...\n", Cyan(name))
+		return
 	} else {
-		l.printf("Connecting to %s: %s (%s)\n", Cyan(l.currentMachine), Yellow("ERROR"), l.machines[l.currentMachine].err)
+		l.printf("Connecting to %s: %s (%s)\n", Cyan(name), Yellow("ERROR"), status.err)
 		return
 	}
 
-	for _, assertionInfo := range l.assertionInfo {
-		l.printf("  %s.%s: ", sanitizeName(assertionInfo.specName), sanitizeName(assertionInfo.name))
-		if assertionInfo.result == nil {
-			l.printf(Yellow("RUNNING"))
-		} else {
-			if assertionInfo.result.Result == AssertionNoop {
-				l.printf(Green(assertionInfo.result.String()))
-			} else if assertionInfo.result.Result == AssertionFailed {
-				l.printf(Red(assertionInfo.result.String()))
-			} else if assertionInfo.result.Result == AssertionApplied {
-				l.printf(Yellow(assertionInfo.result.String()))
-			} else {
-				l.printf(Red(assertionInfo.result.String()))
-				if assertionInfo.err != nil {
-					l.printf(" (%v)", assertionInfo.err)
-				}
+	for _, assertionInfo := range status.assertions {
+		l.printf("  %s\r\n", assertionInfo)
+
+		if assertionInfo.result != nil && assertionInfo.result.Result != AssertionNoop && assertionInfo.result.Result != AssertionApplied {
+			for _, output := range assertionInfo.result.Output {
+				l.paintOutput(output)
 			}
 		}
+	}
+}
 
-		if l.currentMachine != assertionInfo.machine {
-			l.printf(" (%s)", assertionInfo.machine)
-		}
-		l.printf("\r\n")
+// String returns the name and status of the assertion, with the error if it errored.
+func (info *assertionInfo) String() string {
+	out := sanitizeName(info.specName) + "." + sanitizeName(info.name) + ": "
+	if info.result == nil {
+		return out + Yellow("RUNNING")
+	}
+	out += colorResult(info.result)
+	if info.result.IsError() && info.err != nil {
+		out += fmt.Sprintf(" (%v)", info.err)
 	}
+	return out
+}
+
+// maxOutputLines is the maximum number of lines of stdout/stderr shown for a failed command.
+const maxOutputLines = 10
+
+func (l *ConsoleLogger) paintOutput(output *CommandOutput) {
+	for _, line := range outputLines(output) {
+		l.printf("    %s\r\n", line)
+	}
+}
 
+// outputLines returns the command followed by the last maxOutputLines of its stdout and stderr.
+func outputLines(output *CommandOutput) []string {
+	out := []string{fmt.Sprintf("$ %s (exit status %d)", output.Command, output.ExitStatus)}
+	for _, stream := range []string{output.Stdout, output.Stderr} {
+		lines := strings.Split(strings.TrimRight(stream, "\n"), "\n")
+		if len(lines) > maxOutputLines {
+			lines = lines[len(lines)-maxOutputLines:]
+		}
+		for _, line := range lines {
+			if line != "" {
+				out = append(out, Color(line, Dim))
+			}
+		}
+	}
+	return out
 }
 
 // LogAssertionStatus is called with assertion information when an assertion changes status.
-func (l *ConsoleLogger) LogAssertionStatus(specName, assertionName string, assertion *config.Assertion,
+func (l *ConsoleLogger) LogAssertionStatus(machineName, specName, assertionName string, assertion *config.Assertion,
 	assertionResult *AssertionResult, err error) {
-	if assertionResult == nil {
-		l.assertionInfo = append(l.assertionInfo, &assertionInfo{
-			machine:   l.currentMachine,
-			assertion: assertion,
-			name:      assertionName,
-			specName:  specName,
-		})
-	} else {
-		for i := range l.assertionInfo {
-			if l.assertionInfo[i].assertion == assertion {
-				l.assertionInfo[i].result = assertionResult
-				l.assertionInfo[i].err = err
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.setAssertionStatus(machineName, specName, assertionName, assertion, assertionResult, err)
+	l.paint()
+}
+
+// LogRunSummary prints a per-machine tally of assertion results, ordered by machine name.
+func (l *ConsoleLogger) LogRunSummary() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.printSummary()
+	l.linesPrinted = 0
+}
+
+// printSummary prints a per-machine tally of assertion results, ordered by machine name.
+func (s *runStatus) printSummary() {
+	names := make([]string, 0, len(s.machines))
+	for name := range s.machines {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Print("\nSummary:\n")
+	for _, name := range names {
+		status := s.machines[name]
+		if status.err != nil {
+			fmt.Printf("  %s: %s (%s)\n", Cyan(name), Red("CONNECTION ERROR"), status.err)
+			continue
+		}
+
+		counts := map[int]int{}
+		ignored := 0
+		var failed []*assertionInfo
+		for _, info := range status.assertions {
+			if info.result == nil {
+				continue
 			}
+			if info.result.Result == AssertionNoop || info.result.Result == AssertionApplied || info.result.Result == AssertionSkipped {
+				counts[info.result.Result]++
+				continue
+			}
+			if info.assertion.IgnoreErrors {
+				ignored++
+			} else {
+				counts[info.result.Result]++
+			}
+			failed = append(failed, info)
+		}
+		fmt.Printf("  %s: %d ok, %d applied, %d failed, %d errored", Cyan(name), counts[AssertionNoop],
+			counts[AssertionApplied], counts[AssertionFailed], counts[AssertionError]+counts[AssertionApplyError])
+		if ignored > 0 {
+			fmt.Printf(", %d ignored", ignored)
+		}
+		if counts[AssertionSkipped] > 0 {
+			fmt.Printf(", %d skipped", counts[AssertionSkipped])
+		}
+		fmt.Println()
+
+		for _, info := range failed {
+			fmt.Printf("    %s.%s: %s", sanitizeName(info.specName), sanitizeName(info.name), colorResult(info.result))
+			if info.assertion.IgnoreErrors {
+				fmt.Print(" (ignored)")
+			} else if info.result.IsError() && info.err != nil {
+				fmt.Printf(" (%v)", info.err)
+			}
+			fmt.Println()
 		}
 	}
-	l.paint()
 }
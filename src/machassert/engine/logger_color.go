@@ -43,10 +43,19 @@ const (
 	BgWhite   = "47"
 )
 
+// colorDisabled is set by DisableColor.
+var colorDisabled bool
+
+// DisableColor makes Color (and the functions which use it) return text as-is. It must be called
+// before anything is logged.
+func DisableColor() {
+	colorDisabled = true
+}
+
 // Color returns a colored text based on the specified style and color codes.
 func Color(text string, colors ...string) string {
 	// The windows terminal has no color support.
-	if runtime.GOOS == "windows" {
+	if runtime.GOOS == "windows" || colorDisabled {
 		return text
 	}
 	return esc(strings.Join(colors, ";")) + text + esc(Default)
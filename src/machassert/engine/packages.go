@@ -0,0 +1,174 @@
+package engine
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"machassert/config"
+	"machassert/machine"
+	"os"
+	"strings"
+)
+
+// Package managers
+const (
+	pkgManagerDpkg = "dpkg-query"
+	pkgManagerRPM  = "rpm"
+	pkgManagerApk  = "apk"
+)
+
+const apkInstalledDB = "/lib/apk/db/installed"
+
+// detectPackageManager returns the package manager present on the machine.
+func detectPackageManager(m Machine) (string, error) {
+	out, err := m.Run("sh", []string{"-c", "for pm in dpkg-query rpm apk; do command -v $pm >/dev/null 2>&1 && { echo $pm; exit 0; }; done; exit 1"})
+	if _, isExit := machine.ExitStatus(err); isExit {
+		return "", errors.New("no supported package manager (dpkg, rpm, apk) found")
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// installedVersions returns the installed versions of the package, or none if it is not installed. Only rpm
+// can have several versions of a package installed at once (eg kernels, or packages for multiple architectures).
+func installedVersions(m Machine, pkgManager, pkg string) ([]string, error) {
+	switch pkgManager {
+	case pkgManagerDpkg:
+		out, err := m.Run("dpkg-query", []string{"-W", "-f", "${Status}\t${Version}", pkg})
+		if _, isExit := machine.ExitStatus(err); isExit {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		spl := strings.SplitN(string(out), "\t", 2)
+		if len(spl) != 2 || !strings.HasSuffix(spl[0], " installed") {
+			return nil, nil
+		}
+		return []string{strings.TrimSpace(spl[1])}, nil
+
+	case pkgManagerRPM:
+		out, err := m.Run("rpm", []string{"-q", "--qf", "%{EPOCH}:%{VERSION}-%{RELEASE}\n", pkg})
+		if _, isExit := machine.ExitStatus(err); isExit {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return parseRPMVersions(out), nil
+
+	case pkgManagerApk:
+		version, installed, err := apkInstalledVersion(m, pkg)
+		if !installed {
+			return nil, err
+		}
+		return []string{version}, nil
+	}
+	return nil, errors.New("unsupported package manager: " + pkgManager)
+}
+
+// parseRPMVersions parses the output of rpm -q, with a line of the form <epoch>:<version>-<release> for
+// each installed instance of the package. rpm prints an epoch of (none) if the package does not have one.
+func parseRPMVersions(out []byte) []string {
+	var versions []string
+	for _, line := range strings.Split(string(out), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		versions = append(versions, strings.TrimPrefix(line, "(none):"))
+	}
+	return versions
+}
+
+// apkInstalledVersion reads the version of a package from the apk database. Each package is a
+// paragraph of lines, with the package name on a line beginning with P: and the version on V:.
+func apkInstalledVersion(m Machine, pkg string) (string, bool, error) {
+	f, err := m.ReadFile(apkInstalledDB)
+	if err != nil && os.IsNotExist(err) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	defer f.Close()
+
+	var name, version string
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		line := s.Text()
+		switch {
+		case line == "":
+			if name == pkg {
+				return version, true, nil
+			}
+			name, version = "", ""
+		case strings.HasPrefix(line, "P:"):
+			name = line[2:]
+		case strings.HasPrefix(line, "V:"):
+			version = line[2:]
+		}
+	}
+	if name == pkg {
+		return version, true, nil
+	}
+	return "", false, s.Err()
+}
+
+func applyPackageInstalledAssertion(machine Machine, assertion *config.Assertion) (*AssertionResult, error) {
+	pkgManager, err := detectPackageManager(machine)
+	if err != nil {
+		return &AssertionResult{Result: AssertionError}, err
+	}
+	versions, err := installedVersions(machine, pkgManager, assertion.Package)
+	if err != nil {
+		return &AssertionResult{Result: AssertionError}, err
+	}
+	if len(versions) == 0 {
+		return &AssertionResult{Result: AssertionApplied}, nil
+	}
+	if assertion.Version == "" {
+		return &AssertionResult{Result: AssertionNoop}, nil
+	}
+
+	// The assertion holds if any installed version satisfies the constraint.
+	constraint, err := config.ParseVersionConstraint(assertion.Version)
+	if err != nil {
+		return &AssertionResult{Result: AssertionError}, err
+	}
+	for _, version := range versions {
+		if constraint.Matches(version) {
+			return &AssertionResult{Result: AssertionNoop}, nil
+		}
+	}
+	return &AssertionResult{Result: AssertionApplied}, nil
+}
+
+func installPackageAction(m Machine, assertion *config.Assertion, action *config.Action) error {
+	pkg := action.Package
+	if pkg == "" {
+		pkg = assertion.Package
+	}
+
+	pkgManager, err := detectPackageManager(m)
+	if err != nil {
+		return err
+	}
+	switch pkgManager {
+	case pkgManagerDpkg:
+		_, err = m.Run("env", []string{"DEBIAN_FRONTEND=noninteractive", "apt-get", "install", "-y", "-q", pkg})
+	case pkgManagerRPM:
+		installer := "yum"
+		if out, _ := m.Run("sh", []string{"-c", "command -v dnf || true"}); len(bytes.TrimSpace(out)) > 0 {
+			installer = "dnf"
+		}
+		_, err = m.Run(installer, []string{"install", "-y", "-q", pkg})
+	case pkgManagerApk:
+		_, err = m.Run("apk", []string{"add", "-q", pkg})
+	default:
+		err = errors.New("unsupported package manager: " + pkgManager)
+	}
+	return err
+}
@@ -0,0 +1,23 @@
+package engine
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseRPMVersions(t *testing.T) {
+	tcs := []struct {
+		out  string
+		want []string
+	}{
+		{"", nil},
+		{"(none):1.20.1-1.el9\n", []string{"1.20.1-1.el9"}},
+		{"1:2.4.57-5.el9\n", []string{"1:2.4.57-5.el9"}},
+		{"(none):5.14.0-70.el9\n(none):5.14.0-162.el9\n", []string{"5.14.0-70.el9", "5.14.0-162.el9"}},
+	}
+	for _, tc := range tcs {
+		if got := parseRPMVersions([]byte(tc.out)); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("parseRPMVersions(%q) = %q, want %q", tc.out, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package engine
+
+import (
+	"fmt"
+	"machassert/config"
+	"sync"
+
+	"github.com/howeyc/gopass"
+)
+
+// PlainLogger implements the Logger interface by printing a line each time the status of a machine or
+// assertion changes. Unlike ConsoleLogger, nothing is redrawn, so it is suitable for output which is
+// not a terminal. Lines are prefixed with the machine name, as machines may be asserted on in parallel.
+type PlainLogger struct {
+	mu sync.Mutex
+	runStatus
+}
+
+// KeyboardInteractiveAuth is called by a machine object if authKind = 'prompt', and a keyboard interactive authentication session is initiated by the server.
+func (l *PlainLogger) KeyboardInteractiveAuth(user, instruction string, questions []string, echos []bool) ([]string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if instruction != "" {
+		fmt.Printf("%s (%s)\n", instruction, user)
+	}
+	var answers []string
+	for _, q := range questions {
+		fmt.Printf("\t%s", q)
+		r, err := gopass.GetPasswd()
+		if err != nil {
+			return nil, err
+		}
+		answers = append(answers, string(r))
+	}
+	return answers, nil
+}
+
+// AuthenticationPrompt is called by a machine object if authKind = 'prompt', and a password is required.
+func (l *PlainLogger) AuthenticationPrompt(prompt string) (string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	fmt.Print(prompt)
+	pw, err := gopass.GetPasswd()
+	return string(pw), err
+}
+
+// LogMachineStatus is called when a machine's (being asserted against) status changes.
+func (l *PlainLogger) LogMachineStatus(name string, isConnected bool, m *config.Machine, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	status := l.machineStatus(name)
+	status.machine = m
+	status.isConnected = isConnected
+	status.err = err
+
+	switch {
+	case err != nil:
+		fmt.Printf("%s: %s (%s)\n", Cyan(name), Yellow("ERROR"), err)
+	case isConnected:
+		fmt.Printf("%s: %s\n", Cyan(name), Green("CONNECTED"))
+	default:
+		fmt.Printf("%s: CONNECTING\n", Cyan(name))
+	}
+}
+
+// LogAssertionStatus is called with assertion information when an assertion changes status.
+func (l *PlainLogger) LogAssertionStatus(machineName, specName, assertionName string, assertion *config.Assertion,
+	assertionResult *AssertionResult, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.setAssertionStatus(machineName, specName, assertionName, assertion, assertionResult, err)
+	info := &assertionInfo{name: assertionName, specName: specName, assertion: assertion, result: assertionResult, err: err}
+	fmt.Printf("%s: %s\n", Cyan(machineName), info)
+
+	if assertionResult != nil && assertionResult.Result != AssertionNoop && assertionResult.Result != AssertionApplied {
+		for _, output := range assertionResult.Output {
+			for _, line := range outputLines(output) {
+				fmt.Printf("%s:     %s\n", Cyan(machineName), line)
+			}
+		}
+	}
+}
+
+// LogRunSummary prints a per-machine tally of assertion results, ordered by machine name.
+func (l *PlainLogger) LogRunSummary() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.printSummary()
+}
@@ -0,0 +1,88 @@
+package engine
+
+import (
+	"machassert/config"
+	"sort"
+	"strings"
+)
+
+// PlannedAction describes an action which would have been applied to a machine,
+// had the executor not been running in dry-run mode.
+type PlannedAction struct {
+	Machine string
+	// Assertion is the fully qualified name of the assertion which owns the action,
+	// in the form <spec>.<assertion>[.<nested assertion>...].
+	Assertion string
+	Action    *config.Action
+}
+
+// String returns a human readable description of the action.
+func (p *PlannedAction) String() string {
+	return describeAction(p.Action)
+}
+
+// describeAction returns a human readable description of an action.
+func describeAction(action *config.Action) string {
+	switch action.Kind {
+	case config.ActionCopyFile:
+		return action.Kind + " " + action.SourcePath + " -> " + action.DestinationPath
+	case config.ActionTemplate:
+		return action.Kind + " " + action.Template + " -> " + action.DestinationPath
+	case config.ActionAssert:
+		return action.Kind + " " + strings.Join(sortAssertions(action.Assertions), ", ")
+	case config.ActionInstallPackage:
+		if action.Package != "" {
+			return action.Kind + " " + action.Package
+		}
+		return action.Kind
+	case config.ActionRun:
+		return action.Kind + " " + action.Command
+	case config.ActionService:
+		return strings.TrimSpace(action.Kind + " " + action.Operation + " " + action.Service)
+	case config.ActionMkdir, config.ActionDelete:
+		return action.Kind + " " + action.Path
+	case config.ActionSymlink:
+		return action.Kind + " " + action.Path + " -> " + action.Target
+	case config.ActionChmod:
+		return action.Kind + " " + action.Mode + " " + action.Path
+	case config.ActionChown:
+		owner := action.Owner
+		if action.Group != "" {
+			owner += ":" + action.Group
+		}
+		return action.Kind + " " + owner + " " + action.Path
+	default:
+		return action.Kind
+	}
+}
+
+// SetDryRun configures the executor to evaluate assertions without applying any actions.
+// Actions which would have been applied are recorded, and can be retrieved with Plan().
+// Nested ASSERT actions are still evaluated. FAIL actions are not recorded, and do not stop execution.
+func (e *Executor) SetDryRun(dryRun bool) {
+	e.dryRun = dryRun
+}
+
+// Plan returns the actions recorded during a dry run, ordered by machine name.
+// Actions for a machine are in the order they would have been applied.
+func (e *Executor) Plan() []*PlannedAction {
+	e.planLock.Lock()
+	defer e.planLock.Unlock()
+
+	out := make([]*PlannedAction, len(e.plan))
+	copy(out, e.plan)
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Machine < out[j].Machine
+	})
+	return out
+}
+
+func (e *Executor) recordPlannedAction(machine Machine, action *config.Action, printPrefix string) {
+	e.planLock.Lock()
+	defer e.planLock.Unlock()
+	e.plan = append(e.plan, &PlannedAction{
+		Machine:   machine.Name(),
+		Assertion: printPrefix,
+		Action:    action,
+	})
+}
@@ -0,0 +1,50 @@
+package engine
+
+import (
+	"machassert/config"
+	"reflect"
+	"testing"
+)
+
+// namedMachine is a Machine which only has a name. Calling any other method panics.
+type namedMachine struct {
+	Machine
+	name string
+}
+
+func (m *namedMachine) Name() string {
+	return m.name
+}
+
+func TestDryRunPlan(t *testing.T) {
+	e := New(&config.MachineSpec{}, nil)
+	e.SetDryRun(true)
+	assertion := &config.Assertion{Kind: config.FileExistsAssrt, FilePath: "/srv/app"}
+	actions := []*config.Action{
+		{Kind: config.ActionMkdir, Path: "/srv/app"},
+		{Kind: config.ActionFail},
+		{},
+		{Kind: config.ActionRun, Command: "systemctl restart app"},
+	}
+	for _, m := range []string{"web-2", "web-1"} {
+		for _, action := range actions {
+			if err := doAction(&namedMachine{name: m}, assertion, action, &AssertionResult{}, e, "base.app"); err != nil {
+				t.Errorf("%s: %s: %v", m, action.Kind, err)
+			}
+		}
+	}
+
+	var got []string
+	for _, p := range e.Plan() {
+		got = append(got, p.Machine+" "+p.Assertion+": "+p.String())
+	}
+	want := []string{
+		"web-1 base.app: MKDIR /srv/app",
+		"web-1 base.app: RUN systemctl restart app",
+		"web-2 base.app: MKDIR /srv/app",
+		"web-2 base.app: RUN systemctl restart app",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,110 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Report formats
+const (
+	ReportJUnit = "junit"
+	ReportTAP   = "tap"
+)
+
+// ReportFormats lists the formats supported by WriteReport.
+var ReportFormats = []string{ReportJUnit, ReportTAP}
+
+// machineReport holds what happened on a machine, for reports.
+type machineReport struct {
+	name    string
+	err     error // the machine could not be asserted on
+	results []*Result
+}
+
+// WriteReport writes a report of the results and failures of a run (from Results() and Failures()) in
+// the given format. Each machine is a test suite, and each assertion (including nested ASSERT assertions)
+// is a test case.
+func WriteReport(w io.Writer, format string, results []*Result, failures []*Failure) error {
+	machines := groupByMachine(results, failures)
+	switch format {
+	case ReportJUnit:
+		return writeJUnit(w, machines)
+	case ReportTAP:
+		return writeTAP(w, machines)
+	}
+	return errors.New("unknown report format: " + format)
+}
+
+// groupByMachine returns the results of each machine, ordered by machine name. The results of each machine
+// keep their order.
+func groupByMachine(results []*Result, failures []*Failure) []*machineReport {
+	var out []*machineReport
+	byName := map[string]*machineReport{}
+	get := func(name string) *machineReport {
+		if byName[name] == nil {
+			byName[name] = &machineReport{name: name}
+			out = append(out, byName[name])
+		}
+		return byName[name]
+	}
+
+	for _, f := range failures {
+		if f.Assertion == "" {
+			get(f.Machine).err = f.Err
+		}
+	}
+	for _, r := range results {
+		m := get(r.Machine)
+		m.results = append(m.results, r)
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].name < out[j].name
+	})
+	return out
+}
+
+// elapsed returns the time between the first assertion starting and the last finishing.
+func (m *machineReport) elapsed() time.Duration {
+	var start, end time.Time
+	for _, r := range m.results {
+		if start.IsZero() || r.Start.Before(start) {
+			start = r.Start
+		}
+		if finish := r.Start.Add(r.Duration); finish.After(end) {
+			end = finish
+		}
+	}
+	return end.Sub(start)
+}
+
+// failedInNested returns true if the assertion only failed because an assertion of its ASSERT action did.
+// Such failures are reported against the nested assertion, as they are in Failures, so the assertion owning
+// it is reported as passing.
+func failedInNested(r *Result) bool {
+	return r.Err != nil && failedInNestedAssertion(r.Result)
+}
+
+// failureMessage returns the reason the assertion did not hold.
+func failureMessage(r *Result) string {
+	if r.Err != nil {
+		return r.Err.Error()
+	}
+	return r.Result.String()
+}
+
+// formatOutput returns the output of the commands run by an assertion, in the form they were run in a shell.
+func formatOutput(output []*CommandOutput) string {
+	var b strings.Builder
+	for _, o := range output {
+		fmt.Fprintf(&b, "$ %s\n", o.Command)
+		b.WriteString(o.Stdout)
+		b.WriteString(o.Stderr)
+		fmt.Fprintf(&b, "(exit status %d)\n", o.ExitStatus)
+	}
+	return b.String()
+}
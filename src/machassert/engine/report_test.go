@@ -0,0 +1,127 @@
+package engine
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"flag"
+	"io/ioutil"
+	"machassert/config"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+// testReportRun returns the results and failures of a run covering every kind of test case: assertions which
+// held, were applied, failed, errored, had errors ignored or were not run, an assertion whose nested assertion
+// failed, and a machine which could not be connected to.
+func testReportRun() ([]*Result, []*Failure) {
+	start := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	at := func(ms int) time.Time {
+		return start.Add(time.Duration(ms) * time.Millisecond)
+	}
+
+	results := []*Result{
+		{
+			Machine: "web-1", Assertion: "base.motd", Kind: "exists",
+			Result: &AssertionResult{Result: AssertionNoop}, Start: at(0), Duration: 12 * time.Millisecond,
+		},
+		{
+			Machine: "web-1", Assertion: "base.nginx config", Kind: "md5_match",
+			Result: &AssertionResult{Result: AssertionApplied}, Start: at(12), Duration: 250 * time.Millisecond,
+		},
+		{
+			Machine: "web-1", Assertion: "base.nginx.running", Kind: "command",
+			Result: &AssertionResult{Result: AssertionFailed, Output: []*CommandOutput{
+				{Command: "systemctl is-active nginx", ExitStatus: 3, Stdout: "inactive\n", Stderr: "unit \"nginx\" is\tdown \x1b[0m\n"},
+			}},
+			Err: ErrAssertionsFailed, Start: at(262), Duration: 40 * time.Millisecond,
+		},
+		{
+			Machine: "web-1", Assertion: "base.nginx", Kind: "exists",
+			Result: &AssertionResult{Result: AssertionFailed, Actions: []*config.Action{{Kind: config.ActionAssert}}},
+			Err:    ErrAssertionsFailed, Start: at(262), Duration: 41 * time.Millisecond,
+		},
+		{
+			Machine: "web-1", Assertion: "base.app", Kind: "service_running",
+			Result: &AssertionResult{Result: AssertionSkipped}, SkipReason: "an earlier assertion failed", Start: at(302),
+		},
+		{
+			Machine: "web-2", Assertion: "base.motd", Kind: "exists",
+			Result: &AssertionResult{Result: AssertionError}, Err: errors.New(`open "/etc/motd": permission denied`),
+			Start: at(5), Duration: 8 * time.Millisecond,
+		},
+		{
+			Machine: "web-2", Assertion: "base.cache", Kind: "command", Ignored: true,
+			Result: &AssertionResult{Result: AssertionApplyError, Output: []*CommandOutput{
+				{Command: "rm -rf /var/cache/app", ExitStatus: 1, Stderr: "rm: café: busy\n"},
+			}},
+			Err: errors.New("exit status 1"), Start: at(13), Duration: 1500 * time.Millisecond,
+		},
+		{
+			Machine: "web-2", Assertion: "base.nginx config", Kind: "md5_match",
+			Result: &AssertionResult{Result: AssertionSkipped}, SkipReason: "not selected", Start: at(1513),
+		},
+	}
+	failures := []*Failure{
+		{Machine: "db-1", Err: errors.New("dial tcp 10.0.0.5:22: connection refused")},
+		{Machine: "web-1", Assertion: "base.nginx.running", Err: ErrAssertionsFailed},
+		{Machine: "web-2", Assertion: "base.motd", Err: results[5].Err},
+		{Machine: "web-2", Assertion: "base.cache", Err: results[6].Err, Ignored: true},
+	}
+	return results, failures
+}
+
+func TestWriteReport(t *testing.T) {
+	results, failures := testReportRun()
+	for format, golden := range map[string]string{ReportJUnit: "report.xml", ReportTAP: "report.tap"} {
+		var out bytes.Buffer
+		if err := WriteReport(&out, format, results, failures); err != nil {
+			t.Fatalf("%s: %v", format, err)
+		}
+
+		if format == ReportJUnit {
+			if err := xml.Unmarshal(out.Bytes(), &junitTestSuites{}); err != nil {
+				t.Errorf("invalid JUnit XML: %v", err)
+			}
+		}
+
+		path := filepath.Join("testdata", golden)
+		if *update {
+			if err := ioutil.WriteFile(path, out.Bytes(), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		want, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(out.Bytes(), want) {
+			t.Errorf("%s report does not match %s (run with -update to regenerate):\n%s", format, path, out.String())
+		}
+	}
+}
+
+func TestWriteReportUnknownFormat(t *testing.T) {
+	if err := WriteReport(ioutil.Discard, "html", nil, nil); err == nil || err.Error() != "unknown report format: html" {
+		t.Errorf("Got %v, want 'unknown report format: html'", err)
+	}
+}
+
+func TestYAMLString(t *testing.T) {
+	tcs := map[string]string{
+		"plain":              `"plain"`,
+		`say "hi" \ bye`:     `"say \"hi\" \\ bye"`,
+		"two\nlines\r\tend":  `"two\nlines\r\tend"`,
+		"\x1b[0m\x7f":        `"\u001b[0m\u007f"`,
+		"café \u2028 \u0085": `"café \u2028 \u0085"`,
+		"bad \xff utf8":      "\"bad � utf8\"",
+	}
+	for in, want := range tcs {
+		if got := yamlString(in); got != want {
+			t.Errorf("yamlString(%q) = %s, want %s", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package engine
+
+import (
+	"sort"
+	"time"
+)
+
+// Result records an assertion which was run on a machine (or skipped, as an assertion it
+// depends on failed), for reports.
+type Result struct {
+	Machine   string
+	Assertion string // qualified name of the assertion, including nested ASSERT assertions
+	Kind      string
+	Result    *AssertionResult
+	Err       error
+	Ignored   bool // the assertion has ignore_errors set
+	Start     time.Time
+	Duration  time.Duration
+
+	SkipReason string // why the assertion was not run, if Result is AssertionSkipped
+}
+
+// Skipped returns true if the assertion was not run.
+func (r *Result) Skipped() bool {
+	return r.Result.Result == AssertionSkipped
+}
+
+func (e *Executor) recordResult(r *Result) {
+	e.resultsLock.Lock()
+	defer e.resultsLock.Unlock()
+	e.results = append(e.results, r)
+}
+
+// Results returns every assertion run (or skipped) by Run, ordered by machine name, and then by
+// the time the assertion started. Nested ASSERT assertions come after the assertion which owns them.
+func (e *Executor) Results() []*Result {
+	e.resultsLock.Lock()
+	defer e.resultsLock.Unlock()
+
+	out := make([]*Result, len(e.results))
+	copy(out, e.results)
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].Machine != out[j].Machine {
+			return out[i].Machine < out[j].Machine
+		}
+		return out[i].Start.Before(out[j].Start)
+	})
+	return out
+}
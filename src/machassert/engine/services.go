@@ -0,0 +1,51 @@
+package engine
+
+import (
+	"errors"
+	"machassert/config"
+	"machassert/machine"
+)
+
+// systemctlFalseStatus holds the exit status of each systemctl query when the unit is not active (or enabled).
+// Any other failure, such as systemctl not being installed (127), is an error.
+var systemctlFalseStatus = map[string]int{
+	"is-active":  3,
+	"is-enabled": 1,
+}
+
+// systemctlCheck runs a systemctl query (such as is-active), returning true if it succeeds.
+func systemctlCheck(m Machine, query, unit string) (bool, error) {
+	_, err := m.Run("systemctl", []string{query, "--quiet", unit})
+	if status, isExit := machine.ExitStatus(err); isExit && status == systemctlFalseStatus[query] {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.New("systemctl " + query + " " + unit + ": " + err.Error())
+	}
+	return true, nil
+}
+
+func applyServiceAssertion(machine Machine, assertion *config.Assertion) (*AssertionResult, error) {
+	query := "is-active"
+	if assertion.Kind == config.ServiceEnabledAssrt {
+		query = "is-enabled"
+	}
+
+	ok, err := systemctlCheck(machine, query, assertion.Service)
+	if err != nil {
+		return &AssertionResult{Result: AssertionError}, err
+	}
+	if !ok {
+		return &AssertionResult{Result: AssertionApplied}, nil
+	}
+	return &AssertionResult{Result: AssertionNoop}, nil
+}
+
+func serviceAction(m Machine, assertion *config.Assertion, action *config.Action) error {
+	unit := action.Service
+	if unit == "" {
+		unit = assertion.Service
+	}
+	_, err := m.Run("systemctl", []string{action.Operation, unit})
+	return err
+}
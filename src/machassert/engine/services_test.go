@@ -0,0 +1,45 @@
+package engine
+
+import (
+	"machassert/config"
+	"os/exec"
+	"strconv"
+	"testing"
+)
+
+// exitMachine is a Machine whose Run exits with the given status, implemented by a local shell.
+type exitMachine struct {
+	Machine
+	status int
+}
+
+func (m *exitMachine) Run(name string, args []string) ([]byte, error) {
+	return nil, exec.Command("sh", "-c", "exit "+strconv.Itoa(m.status)).Run()
+}
+
+func TestServiceAssertionExitStatus(t *testing.T) {
+	tcs := []struct {
+		kind   string
+		status int
+		want   int
+	}{
+		{config.ServiceRunningAssrt, 0, AssertionNoop},
+		{config.ServiceRunningAssrt, 3, AssertionApplied},
+		{config.ServiceRunningAssrt, 1, AssertionError},
+		{config.ServiceRunningAssrt, 4, AssertionError},
+		{config.ServiceRunningAssrt, 127, AssertionError},
+		{config.ServiceEnabledAssrt, 0, AssertionNoop},
+		{config.ServiceEnabledAssrt, 1, AssertionApplied},
+		{config.ServiceEnabledAssrt, 3, AssertionError},
+		{config.ServiceEnabledAssrt, 127, AssertionError},
+	}
+	for _, tc := range tcs {
+		result, err := applyServiceAssertion(&exitMachine{status: tc.status}, &config.Assertion{Kind: tc.kind, Service: "nginx"})
+		if result.Result != tc.want {
+			t.Errorf("%s with exit status %d: got %s (%v), want %s", tc.kind, tc.status, result, err, AssertionResult{Result: tc.want})
+		}
+		if (err != nil) != (tc.want == AssertionError) {
+			t.Errorf("%s with exit status %d: unexpected error %v", tc.kind, tc.status, err)
+		}
+	}
+}
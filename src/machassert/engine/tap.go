@@ -0,0 +1,112 @@
+package engine
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// writeTAP writes a TAP version 13 report, with a test point for each assertion. Assertions which have
+// ignore_errors set are marked TODO if they fail, and assertions which were not run are marked SKIP.
+// Each assertion which was run has a YAML block with its kind, result and duration, and the error and
+// command output if it did not hold. An assertion which only failed because of a nested assertion is ok, as
+// the nested assertion is reported as failing. A machine which could not be asserted on is a failed test point.
+func writeTAP(w io.Writer, machines []*machineReport) error {
+	var b strings.Builder
+	count := 0
+	for _, m := range machines {
+		fmt.Fprintf(&b, "# %s\n", m.name)
+		if m.err != nil {
+			count++
+			fmt.Fprintf(&b, "not ok %d - %s\n", count, m.name)
+			writeTAPBlock(&b, [][2]string{{"message", yamlString(m.err.Error())}})
+		}
+
+		for _, r := range m.results {
+			count++
+			name := m.name + ": " + r.Assertion
+			if r.Skipped() {
+				fmt.Fprintf(&b, "ok %d - %s # SKIP %s\n", count, name, r.SkipReason)
+				continue
+			}
+
+			fields := [][2]string{
+				{"kind", yamlString(r.Kind)},
+				{"result", r.Result.String()},
+				{"duration_ms", strconv.FormatInt(r.Duration.Nanoseconds()/1e6, 10)},
+			}
+			if r.Err == nil || failedInNested(r) {
+				fmt.Fprintf(&b, "ok %d - %s\n", count, name)
+				writeTAPBlock(&b, fields)
+				continue
+			}
+
+			if r.Ignored {
+				fmt.Fprintf(&b, "not ok %d - %s # TODO ignored\n", count, name)
+			} else {
+				fmt.Fprintf(&b, "not ok %d - %s\n", count, name)
+			}
+			fields = append([][2]string{{"message", yamlString(failureMessage(r))}}, fields...)
+			if output := formatOutput(r.Result.Output); output != "" {
+				fields = append(fields, [2]string{"output", yamlString(output)})
+			}
+			writeTAPBlock(&b, fields)
+		}
+	}
+
+	_, err := fmt.Fprintf(w, "TAP version 13\n1..%d\n%s", count, b.String())
+	return err
+}
+
+// writeTAPBlock writes a YAML diagnostic block. Values must already be valid YAML scalars, see yamlString.
+func writeTAPBlock(b *strings.Builder, fields [][2]string) {
+	b.WriteString("  ---\n")
+	for _, f := range fields {
+		fmt.Fprintf(b, "  %s: %s\n", f[0], f[1])
+	}
+	b.WriteString("  ...\n")
+}
+
+// yamlString returns s as a YAML double-quoted scalar. Characters YAML does not allow unescaped, such as
+// control characters, are escaped, and invalid UTF-8 is replaced.
+func yamlString(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range strings.ToValidUTF8(s, string(utf8.RuneError)) {
+		switch {
+		case r == '"' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\r':
+			b.WriteString(`\r`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case yamlPrintable(r):
+			b.WriteRune(r)
+		case r <= 0xffff:
+			fmt.Fprintf(&b, `\u%04x`, r)
+		default:
+			fmt.Fprintf(&b, `\U%08x`, r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// yamlPrintable returns true if r can be written unescaped in a YAML double-quoted scalar: it is in the YAML
+// printable character set, and is not a tab, line break (including NEL and the Unicode separators) or BOM.
+func yamlPrintable(r rune) bool {
+	switch {
+	case r >= 0x20 && r <= 0x7e:
+		return true
+	case r == 0x2028 || r == 0x2029 || r == 0xfeff:
+		return false
+	case r >= 0xa0 && r <= 0xd7ff, r >= 0xe000 && r <= 0xfffd, r >= 0x10000 && r <= 0x10ffff:
+		return true
+	}
+	return false
+}
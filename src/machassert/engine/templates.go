@@ -0,0 +1,119 @@
+package engine
+
+import (
+	"bytes"
+	"io/ioutil"
+	"machassert/config"
+	"machassert/util"
+	"os"
+	"path/filepath"
+	"text/template"
+)
+
+// TemplateData is the data available to templates rendered by template_match assertions and TEMPLATE actions.
+type TemplateData struct {
+	Name        string // name of the machine in the targets file
+	Kind        string
+	Destination string
+	Username    string
+	Vars        map[string]string // the vars block of the machine
+}
+
+// templateData returns the template data for the named machine.
+func (e *Executor) templateData(name string) *TemplateData {
+	data := &TemplateData{Name: name, Vars: map[string]string{}}
+	if m := e.machines.Machine[name]; m != nil {
+		data.Kind = m.Kind
+		data.Destination = m.Destination
+		data.Username = m.Username
+		for k, v := range m.Vars {
+			data.Vars[k] = v
+		}
+	}
+	return data
+}
+
+// renderTemplate renders the local template at tmplPath for the given machine.
+func renderTemplate(tmplPath string, data *TemplateData) ([]byte, error) {
+	tmplPath = util.PathSanitize(tmplPath)
+	t, err := template.New(filepath.Base(tmplPath)).Option("missingkey=error").ParseFiles(tmplPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var out bytes.Buffer
+	if err := t.Execute(&out, data); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// contentMatches returns true if the file at fpath exists and has exactly the given contents.
+func contentMatches(m Machine, fpath string, content []byte) (bool, error) {
+	f, err := m.ReadFile(fpath)
+	if err != nil && os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	existing, err := ioutil.ReadAll(f)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(existing, content), nil
+}
+
+func applyTemplateMatchAssertion(m Machine, assertion *config.Assertion, e *Executor) (*AssertionResult, error) {
+	content, err := renderTemplate(assertion.Template, e.templateData(m.Name()))
+	if err != nil {
+		return &AssertionResult{Result: AssertionError}, err
+	}
+
+	ok, err := contentMatches(m, assertion.FilePath, content)
+	if err != nil {
+		return &AssertionResult{Result: AssertionError}, err
+	}
+	if !ok {
+		return &AssertionResult{Result: AssertionApplied}, nil
+	}
+	return &AssertionResult{Result: AssertionNoop}, nil
+}
+
+// templateAction renders the template and writes it to the machine, unless the destination already
+// has the rendered contents.
+func templateAction(m Machine, action *config.Action, e *Executor) error {
+	content, err := renderTemplate(action.Template, e.templateData(m.Name()))
+	if err != nil {
+		return err
+	}
+
+	ok, err := contentMatches(m, action.DestinationPath, content)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		output, err := m.WriteFile(action.DestinationPath)
+		if err != nil {
+			return err
+		}
+		if _, err = output.Write(content); err != nil {
+			output.Close()
+			return err
+		}
+		if err = output.Close(); err != nil {
+			return err
+		}
+	}
+
+	if action.Mode != "" {
+		mode, err := config.ParseMode(action.Mode)
+		if err != nil {
+			return err
+		}
+		return m.Chmod(action.DestinationPath, mode)
+	}
+	return nil
+}
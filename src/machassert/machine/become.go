@@ -0,0 +1,67 @@
+package machine
+
+import (
+	"machassert/config"
+)
+
+// become wraps commands so they run as another user.
+type become struct {
+	method   string
+	user     string
+	password string
+}
+
+// newBecome returns a become for the configuration, prompting for the password if needed.
+// A nil become is returned if b is nil, which runs commands unchanged.
+func newBecome(b *config.Become, auther authPromptProvider) (*become, error) {
+	if b == nil {
+		return nil, nil
+	}
+
+	out := &become{method: b.Method, user: b.User, password: b.Password}
+	if b.Prompt {
+		pw, err := auther.AuthenticationPrompt(b.Method + " password for " + b.User + ": ")
+		if err != nil {
+			return nil, err
+		}
+		out.password = pw
+	}
+	return out, nil
+}
+
+// sudoPasswordScript is run by sh to give the password to sudo without passing it to the command. It reads
+// the password from the first line of stdin and validates it with sudo -v, which caches the credentials,
+// then runs the command (the positional parameters) as the user in $0 with sudo -n, which reuses them.
+// The command only receives the rest of stdin, even if sudo does not need a password. Both sudos are run
+// as children of the shell (hence the trailing exit, as some shells exec the last command), as sudo keys
+// the cached credentials on the parent process when there is no terminal.
+const sudoPasswordScript = `IFS= read -r password && printf '%s\n' "$password" | sudo -S -p '' -v && sudo -n -u "$0" -- "$@"; exit`
+
+// wrap returns the argv which runs name with args as the become user.
+func (b *become) wrap(name string, args []string) []string {
+	if b == nil {
+		return append([]string{name}, args...)
+	}
+
+	switch b.method {
+	case config.BecomeSu:
+		return []string{"su", "-c", shellJoin(append([]string{name}, args...)), b.user}
+	case config.BecomeDoas:
+		return append([]string{"doas", "-n", "-u", b.user, name}, args...)
+	default:
+		prefix := []string{"sudo", "-n", "-u", b.user, "--"}
+		if b.password != "" {
+			prefix = []string{"sh", "-c", sudoPasswordScript, b.user}
+		}
+		return append(prefix, append([]string{name}, args...)...)
+	}
+}
+
+// stdinPrefix returns the bytes which must be written to stdin before any command input. The password is
+// read by the sudoPasswordScript wrapping the command, so is never seen by the command itself.
+func (b *become) stdinPrefix() []byte {
+	if b == nil || b.password == "" {
+		return nil
+	}
+	return []byte(b.password + "\n")
+}
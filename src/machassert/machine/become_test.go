@@ -0,0 +1,155 @@
+package machine
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"machassert/config"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"testing"
+)
+
+func TestBecomeWrap(t *testing.T) {
+	args := []string{"-c", "echo $HOME", "a b"}
+	tcs := []struct {
+		name   string
+		become *become
+		want   []string
+	}{
+		{
+			name: "none",
+			want: []string{"sh", "-c", "echo $HOME", "a b"},
+		},
+		{
+			name:   "sudo",
+			become: &become{method: config.BecomeSudo, user: "root"},
+			want:   []string{"sudo", "-n", "-u", "root", "--", "sh", "-c", "echo $HOME", "a b"},
+		},
+		{
+			name:   "sudo with password",
+			become: &become{method: config.BecomeSudo, user: "deploy", password: "secret"},
+			want:   []string{"sh", "-c", sudoPasswordScript, "deploy", "sh", "-c", "echo $HOME", "a b"},
+		},
+		{
+			name:   "su",
+			become: &become{method: config.BecomeSu, user: "postgres"},
+			want:   []string{"su", "-c", `sh -c 'echo $HOME' 'a b'`, "postgres"},
+		},
+		{
+			name:   "doas",
+			become: &become{method: config.BecomeDoas, user: "root"},
+			want:   []string{"doas", "-n", "-u", "root", "sh", "-c", "echo $HOME", "a b"},
+		},
+	}
+	for _, tc := range tcs {
+		if got := tc.become.wrap("sh", args); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%s: got %q, want %q", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestBecomeStdinPrefix(t *testing.T) {
+	var none *become
+	if p := none.stdinPrefix(); p != nil {
+		t.Errorf("nil become: got %q, want nil", p)
+	}
+	if p := (&become{method: config.BecomeSudo, user: "root"}).stdinPrefix(); p != nil {
+		t.Errorf("no password: got %q, want nil", p)
+	}
+	if p := (&become{method: config.BecomeSudo, user: "root", password: "pw"}).stdinPrefix(); string(p) != "pw\n" {
+		t.Errorf("password: got %q, want %q", p, "pw\n")
+	}
+}
+
+// fakeSudo stands in for sudo when testing sudoPasswordScript. sudo -v checks the password on stdin is
+// "secret" (unless NOPASSWD is set, when stdin is not read) and records its parent process, and sudo -n
+// only runs the command if it has the same parent, as real sudo does when there is no terminal.
+const fakeSudo = `#!/bin/sh
+case "$1" in
+-S)
+	[ -n "$NOPASSWD" ] && exit 0
+	IFS= read -r pw
+	[ "$pw" = secret ] || { echo "Sorry, try again." >&2; exit 1; }
+	echo $PPID > "$STATE/validated"
+	;;
+-n)
+	if [ -z "$NOPASSWD" ] && [ "$(cat "$STATE/validated")" != $PPID ]; then
+		echo "a password is required" >&2
+		exit 1
+	fi
+	echo "$3" > "$STATE/user"
+	shift 4
+	exec "$@"
+	;;
+esac
+`
+
+func TestSudoPasswordScript(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires a POSIX shell")
+	}
+	dir, err := ioutil.TempDir("", "become")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err = ioutil.WriteFile(filepath.Join(dir, "sudo"), []byte(fakeSudo), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	shells := []string{"sh"}
+	for _, shell := range []string{"bash", "dash"} {
+		if _, err := exec.LookPath(shell); err == nil {
+			shells = append(shells, shell)
+		}
+	}
+
+	tcs := []struct {
+		name     string
+		password string
+		nopasswd bool
+		wantErr  bool
+	}{
+		{name: "password", password: "secret"},
+		{name: "nopasswd", password: "secret", nopasswd: true},
+		{name: "wrong password", password: "wrong", wantErr: true},
+	}
+	for _, shell := range shells {
+		for _, tc := range tcs {
+			os.Remove(filepath.Join(dir, "validated"))
+			os.Remove(filepath.Join(dir, "user"))
+
+			b := &become{method: config.BecomeSudo, user: "deploy", password: tc.password}
+			argv := b.wrap("cat", nil)
+			argv[0] = shell
+			cmd := exec.Command(argv[0], argv[1:]...)
+			cmd.Env = append(os.Environ(), "PATH="+dir+string(os.PathListSeparator)+os.Getenv("PATH"), "STATE="+dir)
+			if tc.nopasswd {
+				cmd.Env = append(cmd.Env, "NOPASSWD=1")
+			}
+			cmd.Stdin = io.MultiReader(bytes.NewReader(b.stdinPrefix()), bytes.NewReader([]byte("line 1\nline 2\n")))
+
+			out, err := cmd.Output()
+			if tc.wantErr {
+				if err == nil || len(out) != 0 {
+					t.Errorf("%s: %s: expected the command not to run, got %q, %v", shell, tc.name, out, err)
+				}
+				continue
+			}
+			if err != nil {
+				t.Errorf("%s: %s: %v", shell, tc.name, err)
+				continue
+			}
+			if string(out) != "line 1\nline 2\n" {
+				t.Errorf("%s: %s: the command got %q on stdin, want only the input", shell, tc.name, out)
+			}
+			if user, _ := ioutil.ReadFile(filepath.Join(dir, "user")); string(user) != "deploy\n" {
+				t.Errorf("%s: %s: ran as %q, want deploy", shell, tc.name, user)
+			}
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package machine
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Command describes a command to execute on a machine.
+type Command struct {
+	Name    string
+	Args    []string
+	Dir     string            // working directory, defaults to the home directory (remote) or current directory (local)
+	Env     map[string]string // additional environment variables
+	Stdin   []byte
+	Timeout time.Duration // zero means no timeout
+}
+
+// CommandResult captures the output of a command which ran to completion.
+type CommandResult struct {
+	Stdout     []byte
+	Stderr     []byte
+	ExitStatus int // -1 if the command timed out
+}
+
+// argv returns the argument vector which runs the command in its working directory with its
+// environment. Both are applied by the command itself (rather than the process executing it), so
+// they survive privilege escalation and work over SSH.
+func (c *Command) argv() []string {
+	argv := append([]string{c.Name}, c.Args...)
+	if len(c.Env) > 0 {
+		keys := make([]string, 0, len(c.Env))
+		for k := range c.Env {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		env := []string{"env"}
+		for _, k := range keys {
+			env = append(env, k+"="+c.Env[k])
+		}
+		argv = append(env, argv...)
+	}
+	if c.Dir != "" {
+		argv = append([]string{"sh", "-c", `cd -- "$1" && shift && exec "$@"`, "sh", c.Dir}, argv...)
+	}
+	return argv
+}
+
+func timeoutError(c *Command) error {
+	return fmt.Errorf("command timed out after %s", c.Timeout)
+}
@@ -0,0 +1,106 @@
+package machine
+
+import (
+	"fmt"
+	"os"
+	"os/user"
+	"strconv"
+	"strings"
+)
+
+// mkdirScript returns a shell script which creates the directory fpath with the given mode. If parents
+// is set an existing directory is not an error, and its mode is set like a new one's, as mkdir -p
+// leaves it unchanged.
+func mkdirScript(fpath string, mode os.FileMode, parents bool) string {
+	q := shellQuote(fpath)
+	m := strconv.FormatUint(uint64(unixMode(mode)), 8)
+	if !parents {
+		return "mkdir -m " + m + " -- " + q
+	}
+	return "mkdir -m " + m + " -p -- " + q + " && chmod " + m + " -- " + q
+}
+
+// removeArgs returns the arguments to rm for deleting fpath. Missing files are not an error, and
+// empty directories are deleted like files.
+func removeArgs(fpath string, recursive bool) []string {
+	args := []string{"-f"}
+	if recursive {
+		args = append(args, "-r")
+	} else {
+		args = append(args, "-d")
+	}
+	return append(args, "--", fpath)
+}
+
+// isDirExitCode is the exit status of symlinkScript when fpath is a directory.
+const isDirExitCode = 46
+
+// symlinkScript returns a shell script which creates (or replaces) a symlink at fpath, exiting with
+// isDirExitCode instead if fpath is a directory (rather than a symlink to one), as ln would create
+// the symlink inside it.
+func symlinkScript(target, fpath string) string {
+	q := shellQuote(fpath)
+	return "[ -d " + q + " ] && [ ! -L " + q + " ] && exit " + strconv.Itoa(isDirExitCode) +
+		"; ln -sfn -- " + shellQuote(target) + " " + q
+}
+
+// symlinkExitError converts the exit status of symlinkScript into the error os.Symlink returns when
+// fpath is a directory.
+func symlinkExitError(target, fpath string, exitStatus int) error {
+	if exitStatus == isDirExitCode {
+		return &os.LinkError{Op: "symlink", Old: target, New: fpath, Err: os.ErrExist}
+	}
+	return nil
+}
+
+// chownArgs returns the arguments to chown for setting the owner and/or group of fpath.
+func chownArgs(fpath, owner, group string) []string {
+	spec := owner
+	if group != "" {
+		spec += ":" + group
+	}
+	return []string{"--", spec, fpath}
+}
+
+// lookupUID returns the uid of the given user name or numeric ID, or -1 if owner is empty.
+func lookupUID(owner string) (int, error) {
+	if owner == "" {
+		return -1, nil
+	}
+	if id, err := strconv.Atoi(owner); err == nil {
+		return id, nil
+	}
+	u, err := user.Lookup(owner)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(u.Uid)
+}
+
+// lookupGID returns the gid of the given group name or numeric ID, or -1 if group is empty.
+func lookupGID(group string) (int, error) {
+	if group == "" {
+		return -1, nil
+	}
+	if id, err := strconv.Atoi(group); err == nil {
+		return id, nil
+	}
+	g, err := user.LookupGroup(group)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(g.Gid)
+}
+
+// getentNotFoundExitCode is the exit status of getent when the key is not in the database.
+const getentNotFoundExitCode = 2
+
+// parseGetentID returns the numeric ID (third field) from a passwd or group entry printed by getent.
+func parseGetentID(out []byte) (uint32, error) {
+	fields := strings.Split(strings.TrimSpace(string(out)), ":")
+	if len(fields) < 3 {
+		return 0, fmt.Errorf("unexpected getent output: %q", out)
+	}
+	id, err := strconv.ParseUint(fields[2], 10, 32)
+	return uint32(id), err
+}
@@ -0,0 +1,104 @@
+package machine
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestMkdirScript(t *testing.T) {
+	tcs := []struct {
+		fpath   string
+		mode    os.FileMode
+		parents bool
+		want    string
+	}{
+		{"/srv/app", 0755, false, "mkdir -m 755 -- /srv/app"},
+		{"/srv/a dir", os.ModeSetgid | 0750, true, "mkdir -m 2750 -p -- '/srv/a dir' && chmod 2750 -- '/srv/a dir'"},
+		{"~/cache", 0700, true, "mkdir -m 700 -p -- ~/cache && chmod 700 -- ~/cache"},
+	}
+	for _, tc := range tcs {
+		if got := mkdirScript(tc.fpath, tc.mode, tc.parents); got != tc.want {
+			t.Errorf("mkdirScript(%q, %o, %v) = %q, want %q", tc.fpath, tc.mode, tc.parents, got, tc.want)
+		}
+	}
+}
+
+// TestMkdir checks Local.Mkdir and mkdirScript, which is run on remote machines and when becoming
+// another user, agree, particularly for paths which already exist.
+func TestMkdir(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires POSIX file permissions")
+	}
+	impls := map[string]func(fpath string, mode os.FileMode, parents bool) error{
+		"local": (&Local{}).Mkdir,
+		"script": func(fpath string, mode os.FileMode, parents bool) error {
+			return exec.Command("sh", "-c", mkdirScript(fpath, mode, parents)).Run()
+		},
+	}
+
+	tcs := []struct {
+		name     string
+		existing os.FileMode // mode of the existing directory, if any
+		file     bool        // the path is an existing file
+		parents  bool
+		wantErr  bool
+	}{
+		{name: "new"},
+		{name: "new with parents", parents: true},
+		{name: "existing", existing: 0700, wantErr: true},
+		{name: "existing with parents", existing: 0700, parents: true},
+		{name: "file", file: true, wantErr: true},
+		{name: "file with parents", file: true, parents: true, wantErr: true},
+	}
+	for impl, mkdir := range impls {
+		for _, tc := range tcs {
+			dir, cleanup := tempDir(t)
+			fpath := filepath.Join(dir, "parent", "dir")
+			if tc.existing != 0 {
+				if err := os.MkdirAll(fpath, 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.Chmod(fpath, tc.existing); err != nil {
+					t.Fatal(err)
+				}
+			} else if tc.file {
+				if err := os.MkdirAll(filepath.Dir(fpath), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := ioutil.WriteFile(fpath, nil, 0600); err != nil {
+					t.Fatal(err)
+				}
+			} else if !tc.parents {
+				if err := os.Mkdir(filepath.Dir(fpath), 0755); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			err := mkdir(fpath, 0751, tc.parents)
+			info, statErr := os.Stat(fpath)
+			switch {
+			case tc.wantErr && err == nil:
+				t.Errorf("%s: %s: expected an error", impl, tc.name)
+			case !tc.wantErr && err != nil:
+				t.Errorf("%s: %s: %v", impl, tc.name, err)
+			case statErr != nil:
+				t.Errorf("%s: %s: %v", impl, tc.name, statErr)
+			case tc.file:
+				if info.Mode() != 0600 {
+					t.Errorf("%s: %s: the file's mode changed to %v", impl, tc.name, info.Mode())
+				}
+			case tc.wantErr:
+				if info.Mode().Perm() != tc.existing {
+					t.Errorf("%s: %s: mode changed to %v, want %v", impl, tc.name, info.Mode().Perm(), tc.existing)
+				}
+			case !info.IsDir() || info.Mode().Perm() != 0751:
+				t.Errorf("%s: %s: got %v, want a directory with mode 0751", impl, tc.name, info.Mode())
+			}
+			cleanup()
+		}
+	}
+}
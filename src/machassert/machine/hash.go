@@ -0,0 +1,82 @@
+package machine
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"errors"
+	"hash"
+	"io"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+// Hash algorithms
+const (
+	HashMD5     = "md5"
+	HashSHA1    = "sha1"
+	HashSHA256  = "sha256"
+	HashSHA512  = "sha512"
+	HashBLAKE2b = "blake2b" //BLAKE2b-512, as computed by b2sum
+)
+
+// commandNotFoundExitCode is the exit status of a shell when the command does not exist.
+const commandNotFoundExitCode = 127
+
+// NewHash returns a hash.Hash for the given algorithm. An empty algorithm is treated as md5.
+func NewHash(algorithm string) (hash.Hash, error) {
+	switch algorithm {
+	case "", HashMD5:
+		return md5.New(), nil
+	case HashSHA1:
+		return sha1.New(), nil
+	case HashSHA256:
+		return sha256.New(), nil
+	case HashSHA512:
+		return sha512.New(), nil
+	case HashBLAKE2b:
+		return blake2b.New512(nil)
+	default:
+		return nil, errors.New("unsupported hash algorithm: " + algorithm)
+	}
+}
+
+// sumArgs returns the arguments to sh which run the coreutils command (eg sha256sum) computing the given hash.
+// The command is run through the shell so a missing command always exits with commandNotFoundExitCode,
+// even when wrapped by become.
+func sumArgs(algorithm, fpath string) []string {
+	command := algorithm + "sum"
+	switch algorithm {
+	case "", HashMD5:
+		command = "md5sum"
+	case HashBLAKE2b:
+		command = "b2sum"
+	}
+	return []string{"-c", `exec "$0" "$1"`, command, fpath}
+}
+
+// isCommandNotFound returns true if err indicates the command which was run does not exist.
+func isCommandNotFound(err error) bool {
+	status, isExit := ExitStatus(err)
+	return isExit && status == commandNotFoundExitCode
+}
+
+// streamHash hashes the file by reading it through the machine, for when there is no command
+// available on the machine to hash it.
+func streamHash(readFile func(string) (io.ReadCloser, error), fpath, algorithm string) ([]byte, error) {
+	h, err := NewHash(algorithm)
+	if err != nil {
+		return nil, err
+	}
+	f, err := readFile(fpath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if _, err = io.Copy(h, f); err != nil {
+		return nil, err
+	}
+	return h.Sum(nil), nil
+}
@@ -0,0 +1,102 @@
+package machine
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"testing"
+)
+
+func TestNewHash(t *testing.T) {
+	tcs := map[string]string{
+		"":          "900150983cd24fb0d6963f7d28e17f72",
+		HashMD5:     "900150983cd24fb0d6963f7d28e17f72",
+		HashSHA1:    "a9993e364706816aba3e25717850c26c9cd0d89d",
+		HashSHA256:  "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad",
+		HashSHA512:  "ddaf35a193617abacc417349ae20413112e6fa4e89a97ea20a9eeee64b55d39a2192992a274fc1a836ba3c23a3feebbd454d4423643ce80e2a9ac94fa54ca49f",
+		HashBLAKE2b: "ba80a53f981c4d0d6a2797b69f12f6e94c212f14685ac4b74b12bb6fdbffa2d17d87c5392aab792dc252d5de4533cc9518d38aa8dbf1925ab92386edd4009923",
+	}
+	for algorithm, want := range tcs {
+		h, err := NewHash(algorithm)
+		if err != nil {
+			t.Errorf("%q: %v", algorithm, err)
+			continue
+		}
+		h.Write([]byte("abc"))
+		if got := hex.EncodeToString(h.Sum(nil)); got != want {
+			t.Errorf("%q: got %s, want %s", algorithm, got, want)
+		}
+	}
+
+	if _, err := NewHash("crc32"); err == nil || err.Error() != "unsupported hash algorithm: crc32" {
+		t.Errorf("Got %v, want 'unsupported hash algorithm: crc32'", err)
+	}
+}
+
+func TestSumArgs(t *testing.T) {
+	tcs := map[string]string{
+		"":          "md5sum",
+		HashMD5:     "md5sum",
+		HashSHA1:    "sha1sum",
+		HashSHA256:  "sha256sum",
+		HashSHA512:  "sha512sum",
+		HashBLAKE2b: "b2sum",
+	}
+	for algorithm, command := range tcs {
+		want := []string{"-c", `exec "$0" "$1"`, command, "/etc/a file"}
+		if got := sumArgs(algorithm, "/etc/a file"); !reflect.DeepEqual(got, want) {
+			t.Errorf("%q: got %q, want %q", algorithm, got, want)
+		}
+	}
+}
+
+// TestSumArgsMatchesNewHash checks the coreutils commands run on machines compute the same digests as
+// NewHash, which is used to hash the expected contents.
+func TestSumArgsMatchesNewHash(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires a POSIX shell")
+	}
+	dir, err := ioutil.TempDir("", "hash")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	data := bytes.Repeat([]byte("machassert\x00\xff\n"), 1000)
+	fpath := filepath.Join(dir, "a file")
+	if err = ioutil.WriteFile(fpath, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, algorithm := range []string{HashMD5, HashSHA1, HashSHA256, HashSHA512, HashBLAKE2b} {
+		out, err := exec.Command("sh", sumArgs(algorithm, fpath)...).Output()
+		if isCommandNotFound(err) {
+			t.Logf("%s: %s not installed", algorithm, sumArgs(algorithm, fpath)[2])
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", algorithm, err)
+			continue
+		}
+
+		h, err := NewHash(algorithm)
+		if err != nil {
+			t.Fatal(err)
+		}
+		h.Write(data)
+		want := hex.EncodeToString(h.Sum(nil))
+		if fields := bytes.Fields(out); len(fields) == 0 || string(fields[0]) != want {
+			t.Errorf("%s: got %q, want %s", algorithm, out, want)
+		}
+	}
+
+	err = exec.Command("sh", sumArgs("nosuchalgorithm", fpath)...).Run()
+	if !isCommandNotFound(err) {
+		t.Errorf("expected a missing command to exit with status %d, got %v", commandNotFoundExitCode, err)
+	}
+}
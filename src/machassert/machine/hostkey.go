@@ -0,0 +1,221 @@
+package machine
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"machassert/config"
+	"machassert/util"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// DefaultKnownHostsPath is the known_hosts file used if neither the machine nor ConnectOptions specify one.
+const DefaultKnownHostsPath = "~/.ssh/known_hosts"
+
+// knownHostsLock serializes lookups & writes of known_hosts files when trusting on first use,
+// as multiple machines may be connecting at once.
+var knownHostsLock sync.Mutex
+
+// ConnectOptions describes settings which apply to all remote connections.
+type ConnectOptions struct {
+	// KnownHostsPath is the known_hosts file checked if the machine does not specify one.
+	KnownHostsPath string
+	// TrustOnFirstUse adds the host key of hosts not present in the known_hosts file, instead of refusing to connect.
+	TrustOnFirstUse bool
+}
+
+// knownHostsPath returns the known_hosts file used to verify a machine's host key.
+func knownHostsPath(knownHosts string, opts ConnectOptions) string {
+	path := knownHosts
+	if path == "" {
+		path = opts.KnownHostsPath
+	}
+	if path == "" {
+		path = DefaultKnownHostsPath
+	}
+	return util.PathSanitize(path)
+}
+
+// hostKeyCallback returns a callback which verifies a server's host key against the pinned
+// fingerprint hostKey, or the knownHosts file if no fingerprint is set.
+func hostKeyCallback(hostKey, knownHosts string, opts ConnectOptions) ssh.HostKeyCallback {
+	if hostKey != "" {
+		return func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+			if fingerprintMatches(hostKey, key) {
+				return nil
+			}
+			return fmt.Errorf("host key mismatch for %s: got %s %s, expected %s", hostname, key.Type(), ssh.FingerprintSHA256(key), hostKey)
+		}
+	}
+
+	path := knownHostsPath(knownHosts, opts)
+	return func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+		err := checkKnownHost(path, hostname, remote, key, opts.TrustOnFirstUse)
+		keyErr, isKeyErr := err.(*knownhosts.KeyError)
+		if !isKeyErr {
+			return err
+		}
+
+		if len(keyErr.Want) > 0 {
+			return fmt.Errorf("host key mismatch for %s: got %s %s, expected %s (%s:%d)", hostname, key.Type(),
+				ssh.FingerprintSHA256(key), ssh.FingerprintSHA256(keyErr.Want[0].Key), keyErr.Want[0].Filename, keyErr.Want[0].Line)
+		}
+		return fmt.Errorf("host key %s for %s is not in %s (trust on first use is disabled)", ssh.FingerprintSHA256(key), hostname, path)
+	}
+}
+
+// checkKnownHost checks the key against the known_hosts file at path. If the host has no keys in the
+// file and trustOnFirstUse is set, the key is added to it. Both happen under knownHostsLock, so parallel
+// connections to a new host only add it once.
+func checkKnownHost(path, hostname string, remote net.Addr, key ssh.PublicKey, trustOnFirstUse bool) error {
+	knownHostsLock.Lock()
+	defer knownHostsLock.Unlock()
+
+	var err error = &knownhosts.KeyError{}
+	if _, statErr := os.Stat(path); !os.IsNotExist(statErr) {
+		cb, cbErr := knownhosts.New(path)
+		if cbErr != nil {
+			return cbErr
+		}
+		err = cb(hostname, remote, key)
+	}
+	if keyErr, isKeyErr := err.(*knownhosts.KeyError); isKeyErr && len(keyErr.Want) == 0 && trustOnFirstUse {
+		return addKnownHost(path, hostname, key)
+	}
+	return err
+}
+
+// addKnownHost appends the key to the known_hosts file at path. knownHostsLock must be held.
+func addKnownHost(path, hostname string, key ssh.PublicKey) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+	_, err = f.WriteString(knownhosts.Line([]string{hostname}, key) + "\n")
+	if err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// fingerprintMatches returns true if hostKey (a SHA256:<base64> or MD5:<hex pairs> fingerprint, optionally
+// prefixed with the key type) is of the given key.
+func fingerprintMatches(hostKey string, key ssh.PublicKey) bool {
+	keyType, fingerprint := config.SplitHostKey(hostKey)
+	if keyType != "" && keyType != key.Type() {
+		return false
+	}
+	if strings.HasPrefix(fingerprint, "MD5:") {
+		return strings.ToLower(strings.TrimPrefix(fingerprint, "MD5:")) == ssh.FingerprintLegacyMD5(key)
+	}
+	return strings.TrimRight(fingerprint, "=") == ssh.FingerprintSHA256(key)
+}
+
+// hostKeyAlgorithmPreference lists the (non-certificate) host key algorithms offered when the
+// types of a host's keys are known, in order of preference.
+var hostKeyAlgorithmPreference = []string{
+	ssh.KeyAlgoED25519,
+	ssh.KeyAlgoECDSA256,
+	ssh.KeyAlgoECDSA384,
+	ssh.KeyAlgoECDSA521,
+	ssh.KeyAlgoRSASHA512,
+	ssh.KeyAlgoRSASHA256,
+	ssh.KeyAlgoRSA,
+}
+
+// hostKeyAlgorithmType returns the type of the keys used by a host key algorithm.
+func hostKeyAlgorithmType(algorithm string) string {
+	switch algorithm {
+	case ssh.KeyAlgoRSASHA256, ssh.KeyAlgoRSASHA512:
+		return ssh.KeyAlgoRSA
+	}
+	return algorithm
+}
+
+// hostKeyAlgorithms returns the host key algorithms to offer when connecting to address, so the server
+// presents a key of a type which is known for it: servers present the first offered type they have, which
+// is not necessarily the one which was pinned or recorded in known_hosts. If hostKey has a type, only the
+// algorithms for that type are offered. Otherwise the algorithms for the types of the host's keys in the
+// known_hosts file are offered first. nil (the defaults) is returned if no types are known.
+func hostKeyAlgorithms(address, hostKey, knownHosts string, opts ConnectOptions) ([]string, error) {
+	var keyTypes []string
+	if hostKey != "" {
+		if keyType, _ := config.SplitHostKey(hostKey); keyType != "" {
+			keyTypes = []string{keyType}
+		}
+	} else {
+		var err error
+		if keyTypes, err = knownHostKeyTypes(knownHostsPath(knownHosts, opts), address); err != nil {
+			return nil, err
+		}
+	}
+	if len(keyTypes) == 0 {
+		return nil, nil
+	}
+
+	var known, others []string
+	for _, algorithm := range hostKeyAlgorithmPreference {
+		isKnown := false
+		for _, keyType := range keyTypes {
+			isKnown = isKnown || hostKeyAlgorithmType(algorithm) == keyType
+		}
+		if isKnown {
+			known = append(known, algorithm)
+		} else {
+			others = append(others, algorithm)
+		}
+	}
+	if hostKey != "" {
+		return known, nil
+	}
+	return append(known, others...), nil
+}
+
+// lookupKey is a public key which matches no known_hosts entry, used to list the keys known for a host.
+type lookupKey struct{}
+
+func (lookupKey) Type() string    { return "lookup" }
+func (lookupKey) Marshal() []byte { return nil }
+func (lookupKey) Verify(data []byte, sig *ssh.Signature) error {
+	return errors.New("lookup key cannot verify")
+}
+
+// knownHostKeyTypes returns the types of the keys recorded for address in the known_hosts file at path.
+func knownHostKeyTypes(path, address string) ([]string, error) {
+	knownHostsLock.Lock()
+	defer knownHostsLock.Unlock()
+
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil, nil
+	}
+	cb, err := knownhosts.New(path)
+	if err != nil {
+		return nil, err
+	}
+	// lookupKey never matches, so the error lists every key known for the host.
+	keyErr, isKeyErr := cb(address, &net.TCPAddr{}, lookupKey{}).(*knownhosts.KeyError)
+	if !isKeyErr {
+		return nil, nil
+	}
+
+	var keyTypes []string
+	seen := map[string]bool{}
+	for _, k := range keyErr.Want {
+		if !seen[k.Key.Type()] {
+			seen[k.Key.Type()] = true
+			keyTypes = append(keyTypes, k.Key.Type())
+		}
+	}
+	return keyTypes, nil
+}
@@ -0,0 +1,268 @@
+package machine
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+func testSigner(t *testing.T, keyType string) ssh.Signer {
+	var key interface{}
+	var err error
+	switch keyType {
+	case ssh.KeyAlgoED25519:
+		_, key, err = ed25519.GenerateKey(rand.Reader)
+	case ssh.KeyAlgoECDSA256:
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := ssh.NewSignerFromKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signer
+}
+
+func writeKnownHosts(t *testing.T, path string, lines ...string) {
+	if err := ioutil.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFingerprintMatches(t *testing.T) {
+	key := testSigner(t, ssh.KeyAlgoED25519).PublicKey()
+	other := testSigner(t, ssh.KeyAlgoED25519).PublicKey()
+	sha := ssh.FingerprintSHA256(key)
+	md5 := ssh.FingerprintLegacyMD5(key)
+
+	tcs := []struct {
+		hostKey string
+		want    bool
+	}{
+		{sha, true},
+		{sha + "=", true},
+		{"MD5:" + md5, true},
+		{"MD5:" + strings.ToUpper(md5), true},
+		{"ssh-ed25519 " + sha, true},
+		{"ssh-ed25519 MD5:" + md5, true},
+		{"ecdsa-sha2-nistp256 " + sha, false},
+		{ssh.FingerprintSHA256(other), false},
+		{"MD5:" + ssh.FingerprintLegacyMD5(other), false},
+		{strings.TrimPrefix(sha, "SHA256:"), false},
+		{"", false},
+	}
+	for _, tc := range tcs {
+		if got := fingerprintMatches(tc.hostKey, key); got != tc.want {
+			t.Errorf("fingerprintMatches(%q) = %v, want %v", tc.hostKey, got, tc.want)
+		}
+	}
+}
+
+func TestHostKeyCallbackMismatch(t *testing.T) {
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+	key := testSigner(t, ssh.KeyAlgoED25519).PublicKey()
+	other := testSigner(t, ssh.KeyAlgoED25519).PublicKey()
+	remote := &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 22}
+
+	cb := hostKeyCallback(ssh.FingerprintSHA256(key), "", ConnectOptions{})
+	if err := cb("web:22", remote, key); err != nil {
+		t.Errorf("pinned key: %v", err)
+	}
+	if err := cb("web:22", remote, other); err == nil || !strings.HasPrefix(err.Error(), "host key mismatch for web:22: got ssh-ed25519 "+ssh.FingerprintSHA256(other)) {
+		t.Errorf("pinned key: expected mismatch, got %v", err)
+	}
+
+	path := filepath.Join(dir, "known_hosts")
+	writeKnownHosts(t, path, knownhosts.Line([]string{"db"}, other), knownhosts.Line([]string{"web"}, key))
+	for _, tofu := range []bool{false, true} {
+		cb = hostKeyCallback("", path, ConnectOptions{TrustOnFirstUse: tofu})
+		if err := cb("web:22", remote, key); err != nil {
+			t.Errorf("known_hosts: %v", err)
+		}
+		want := "host key mismatch for web:22: got ssh-ed25519 " + ssh.FingerprintSHA256(other) + ", expected " + ssh.FingerprintSHA256(key) + " (" + path + ":2)"
+		if err := cb("web:22", remote, other); err == nil || err.Error() != want {
+			t.Errorf("trust on first use=%v: got %v, want %q", tofu, err, want)
+		}
+	}
+	if data, _ := ioutil.ReadFile(path); strings.Count(string(data), "\n") != 2 {
+		t.Errorf("mismatched key should not be added to known_hosts, got:\n%s", data)
+	}
+}
+
+func TestHostKeyCallbackTrustOnFirstUse(t *testing.T) {
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+	key := testSigner(t, ssh.KeyAlgoED25519).PublicKey()
+	other := testSigner(t, ssh.KeyAlgoED25519).PublicKey()
+	remote := &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 22}
+	path := filepath.Join(dir, "ssh", "known_hosts")
+
+	err := hostKeyCallback("", path, ConnectOptions{})("web:2222", remote, key)
+	if err == nil || !strings.Contains(err.Error(), "trust on first use is disabled") {
+		t.Errorf("expected unknown host error, got %v", err)
+	}
+	if _, err = os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("known_hosts should not be created without trust on first use")
+	}
+
+	// Connections to the same new host at once must only add it once.
+	cb := hostKeyCallback("", "", ConnectOptions{KnownHostsPath: path, TrustOnFirstUse: true})
+	var wg sync.WaitGroup
+	errs := make([]error, 10)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = cb("web:2222", remote, key)
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := knownhosts.Line([]string{"web:2222"}, key) + "\n"; string(data) != want {
+		t.Errorf("got known_hosts:\n%s\nwant:\n%s", data, want)
+	}
+	if err = cb("web:2222", remote, other); err == nil || !strings.HasPrefix(err.Error(), "host key mismatch") {
+		t.Errorf("expected mismatch for a changed key, got %v", err)
+	}
+}
+
+func TestHostKeyAlgorithms(t *testing.T) {
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+	ed := testSigner(t, ssh.KeyAlgoED25519).PublicKey()
+	ec := testSigner(t, ssh.KeyAlgoECDSA256).PublicKey()
+	path := filepath.Join(dir, "known_hosts")
+	writeKnownHosts(t, path,
+		knownhosts.Line([]string{"ed"}, ed),
+		knownhosts.Line([]string{"both", "[both-port]:2222"}, ec),
+		knownhosts.Line([]string{knownhosts.HashHostname("both")}, ed),
+		knownhosts.Line([]string{"*.wild"}, ec),
+	)
+	others := func(exclude ...string) []string {
+		var out []string
+		for _, a := range hostKeyAlgorithmPreference {
+			if !containsString(exclude, a) {
+				out = append(out, a)
+			}
+		}
+		return out
+	}
+
+	tcs := []struct {
+		name, address, hostKey string
+		want                   []string
+	}{
+		{"ed25519 only", "ed:22", "", append([]string{ssh.KeyAlgoED25519}, others(ssh.KeyAlgoED25519)...)},
+		{"both types", "both:22", "", append([]string{ssh.KeyAlgoED25519, ssh.KeyAlgoECDSA256}, others(ssh.KeyAlgoED25519, ssh.KeyAlgoECDSA256)...)},
+		{"non-standard port", "both-port:2222", "", append([]string{ssh.KeyAlgoECDSA256}, others(ssh.KeyAlgoECDSA256)...)},
+		{"wildcard", "db.wild:22", "", append([]string{ssh.KeyAlgoECDSA256}, others(ssh.KeyAlgoECDSA256)...)},
+		{"unknown host", "new:22", "", nil},
+		{"pinned with type", "ed:22", "ssh-ed25519 " + ssh.FingerprintSHA256(ec), []string{ssh.KeyAlgoED25519}},
+		{"pinned rsa", "ed:22", "ssh-rsa SHA256:abc", []string{ssh.KeyAlgoRSASHA512, ssh.KeyAlgoRSASHA256, ssh.KeyAlgoRSA}},
+		{"pinned without type", "ed:22", ssh.FingerprintSHA256(ed), nil},
+	}
+	for _, tc := range tcs {
+		got, err := hostKeyAlgorithms(tc.address, tc.hostKey, path, ConnectOptions{})
+		if err != nil {
+			t.Errorf("%s: %v", tc.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%s: got %v, want %v", tc.name, got, tc.want)
+		}
+	}
+
+	if got, err := hostKeyAlgorithms("ed:22", "", filepath.Join(dir, "missing"), ConnectOptions{}); err != nil || got != nil {
+		t.Errorf("missing known_hosts: got %v, %v", got, err)
+	}
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// TestHostKeyAlgorithmsHandshake connects to a server with both ECDSA and ed25519 host keys, which
+// presents its ECDSA key by default, when only its ed25519 key is known.
+func TestHostKeyAlgorithmsHandshake(t *testing.T) {
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+	ed := testSigner(t, ssh.KeyAlgoED25519)
+	ec := testSigner(t, ssh.KeyAlgoECDSA256)
+	path := filepath.Join(dir, "known_hosts")
+	writeKnownHosts(t, path, knownhosts.Line([]string{"server"}, ed.PublicKey()))
+
+	tcs := []struct {
+		name, hostKey, knownHosts string
+	}{
+		{name: "known_hosts", knownHosts: path},
+		{name: "pinned", hostKey: "ssh-ed25519 " + ssh.FingerprintSHA256(ed.PublicKey())},
+	}
+	serverConfig := &ssh.ServerConfig{NoClientAuth: true}
+	serverConfig.AddHostKey(ec)
+	serverConfig.AddHostKey(ed)
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			if sshConn, _, _, err := ssh.NewServerConn(conn, serverConfig); err == nil {
+				sshConn.Close()
+			}
+			conn.Close()
+		}
+	}()
+
+	for _, tc := range tcs {
+		sc := &sshConnector{}
+		c, err := sc.clientConfig("server", "user", nil, tc.hostKey, tc.knownHosts)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		conn, err := net.Dial("tcp", l.Addr().String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		sshConn, _, _, err := ssh.NewClientConn(conn, sshAddress("server"), c)
+		if err != nil {
+			conn.Close()
+			t.Errorf("%s: %v", tc.name, err)
+			continue
+		}
+		sshConn.Close()
+	}
+}
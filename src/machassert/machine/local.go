@@ -2,19 +2,24 @@ package machine
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
-	"errors"
 	"io"
+	"io/ioutil"
 	"machassert/util"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"runtime"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // Local represents the current host as an assertion target.
 type Local struct {
 	MachineName string
+	become      *become
 }
 
 // Name returns the name of the target
@@ -22,11 +27,18 @@ func (m *Local) Name() string {
 	return m.MachineName
 }
 
+// command returns a command which runs name as the become user (if any).
+func (m *Local) command(name string, args []string) *exec.Cmd {
+	argv := m.become.wrap(name, args)
+	return exec.Command(argv[0], argv[1:]...)
+}
+
 // Run executes the specified command, returning output.
 func (m *Local) Run(name string, args []string) ([]byte, error) {
 	var out bytes.Buffer
-	cmd := exec.Command(name, args...)
+	cmd := m.command(name, args)
 	cmd.Stdout = &out
+	cmd.Stdin = bytes.NewReader(m.become.stdinPrefix())
 
 	err := cmd.Run()
 	if err != nil {
@@ -35,25 +47,66 @@ func (m *Local) Run(name string, args []string) ([]byte, error) {
 	return out.Bytes(), nil
 }
 
-// Hash returns the MD5 hash of the file at the given path.
-func (m *Local) Hash(fpath string) ([]byte, error) {
-	switch runtime.GOOS {
-	case "linux":
-		o, err := m.Run("md5sum", []string{util.PathSanitize(fpath)})
-		if err != nil {
-			return nil, err
-		}
-		return hex.DecodeString(string(o[:32]))
-	case "darwin":
-		o, err := m.Run("md5", []string{"-q", util.PathSanitize(fpath)})
-		if err != nil {
-			return nil, err
-		}
-		hashStr := strings.Trim(string(o), "\n\t ")
-		return hex.DecodeString(hashStr)
+// Exec runs the command, returning its output and exit status. An error is only returned if the
+// command could not be run to completion.
+func (m *Local) Exec(c *Command) (*CommandResult, error) {
+	ctx := context.Background()
+	if c.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.Timeout)
+		defer cancel()
+	}
+
+	argv := c.argv()
+	argv = m.become.wrap(argv[0], argv[1:])
+	cmd := exec.CommandContext(ctx, argv[0], argv[1:]...)
+	// Children of the command may hold stdout/stderr open after it is killed.
+	cmd.WaitDelay = time.Second
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	cmd.Stdin = io.MultiReader(bytes.NewReader(m.become.stdinPrefix()), bytes.NewReader(c.Stdin))
+
+	err := cmd.Run()
+	result := &CommandResult{Stdout: stdout.Bytes(), Stderr: stderr.Bytes()}
+	if ctx.Err() == context.DeadlineExceeded {
+		result.ExitStatus = -1
+		return result, timeoutError(c)
+	}
+	if status, isExit := ExitStatus(err); isExit {
+		result.ExitStatus = status
+		return result, nil
+	}
+	return result, err
+}
+
+// Hash returns the hash of the file at the given path, using the given algorithm (md5 if empty).
+// If the machine has no command for the algorithm, the file is read and hashed locally.
+func (m *Local) Hash(fpath, algorithm string) ([]byte, error) {
+	if _, err := NewHash(algorithm); err != nil {
+		return nil, err
+	}
+
+	var o []byte
+	var err error
+	switch {
+	case runtime.GOOS == "darwin" && (algorithm == "" || algorithm == HashMD5):
+		o, err = m.Run("md5", []string{"-q", util.PathSanitize(fpath)})
+	case runtime.GOOS == "darwin" && algorithm != HashBLAKE2b:
+		o, err = m.Run("shasum", []string{"-a", strings.TrimPrefix(algorithm, "sha"), util.PathSanitize(fpath)})
+	case runtime.GOOS == "linux":
+		o, err = m.Run("sh", sumArgs(algorithm, util.PathSanitize(fpath)))
 	default:
-		return nil, errors.New("unsupported platform: " + runtime.GOOS)
+		return streamHash(m.ReadFile, fpath, algorithm)
+	}
+	if isCommandNotFound(err) {
+		return streamHash(m.ReadFile, fpath, algorithm)
 	}
+	if err != nil {
+		return nil, err
+	}
+	return hex.DecodeString(strings.Fields(string(o) + " ")[0])
 }
 
 // Grep returns true if the a line in a file match some regular expression.
@@ -70,7 +123,20 @@ func (m *Local) Grep(fpath, regex string) (bool, error) {
 
 // ReadFile returns a reader to a file on a local machine.
 func (m *Local) ReadFile(fpath string) (io.ReadCloser, error) {
-	return os.Open(util.PathSanitize(fpath))
+	if m.become == nil {
+		return os.Open(util.PathSanitize(fpath))
+	}
+
+	out, err := m.Run("sh", []string{"-c", readFileScript(util.PathSanitize(fpath))})
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			if pathErr := readFileExitError(fpath, exitErr.ExitCode()); pathErr != nil {
+				return nil, pathErr
+			}
+		}
+		return nil, err
+	}
+	return ioutil.NopCloser(bytes.NewReader(out)), nil
 }
 
 // Close releases the resources associated with the machine.
@@ -78,7 +144,152 @@ func (m *Local) Close() error {
 	return nil
 }
 
+type cmdWriter struct {
+	cmd *exec.Cmd
+	w   io.WriteCloser
+}
+
+func (w *cmdWriter) Write(in []byte) (int, error) {
+	return w.w.Write(in)
+}
+
+func (w *cmdWriter) Close() error {
+	w.w.Close()
+	return w.cmd.Wait()
+}
+
 // WriteFile returns a writer which can be used to write content to the remote file.
 func (m *Local) WriteFile(fpath string) (io.WriteCloser, error) {
-	return os.OpenFile(util.PathSanitize(fpath), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0755)
+	if m.become == nil {
+		return os.OpenFile(util.PathSanitize(fpath), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0755)
+	}
+
+	cmd := m.command("sh", []string{"-c", "cat - > " + shellQuote(util.PathSanitize(fpath))})
+	pipe, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err = cmd.Start(); err != nil {
+		return nil, err
+	}
+	if _, err = pipe.Write(m.become.stdinPrefix()); err != nil {
+		pipe.Close()
+		cmd.Wait()
+		return nil, err
+	}
+	return &cmdWriter{cmd, pipe}, nil
+}
+
+// Chmod sets the permission bits of the file at the given path.
+func (m *Local) Chmod(fpath string, mode os.FileMode) error {
+	if m.become == nil {
+		return os.Chmod(util.PathSanitize(fpath), mode)
+	}
+	_, err := m.Run("chmod", []string{strconv.FormatUint(uint64(unixMode(mode)), 8), util.PathSanitize(fpath)})
+	return err
+}
+
+// Stat returns information about the file at the given path. Symlinks are not followed.
+func (m *Local) Stat(fpath string) (*FileInfo, error) {
+	fpath = util.PathSanitize(fpath)
+	if m.become == nil {
+		fi, err := os.Lstat(fpath)
+		if err != nil {
+			return nil, err
+		}
+		return localFileInfo(fpath, fi)
+	}
+
+	out, err := m.Run("sh", []string{"-c", statScript(fpath)})
+	if status, isExit := ExitStatus(err); isExit && status == notExistExitCode {
+		return nil, &os.PathError{Op: "stat", Path: fpath, Err: os.ErrNotExist}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return parseStatOutput(out)
+}
+
+// Mkdir creates a directory with the given permissions. If parents is set, missing parents are created
+// and an existing directory is not an error, but has its permissions set.
+func (m *Local) Mkdir(fpath string, mode os.FileMode, parents bool) error {
+	fpath = util.PathSanitize(fpath)
+	if m.become != nil {
+		_, err := m.Run("sh", []string{"-c", mkdirScript(fpath, mode, parents)})
+		return err
+	}
+
+	if parents { // like mkdir -p, mode only applies to the final directory
+		if err := os.MkdirAll(filepath.Dir(fpath), 0777); err != nil {
+			return err
+		}
+	}
+	if err := os.Mkdir(fpath, mode); err != nil {
+		if !parents || !os.IsExist(err) {
+			return err
+		}
+		if info, statErr := os.Stat(fpath); statErr != nil || !info.IsDir() {
+			return err
+		}
+	}
+	return os.Chmod(fpath, mode) // not subject to the umask, and sets the mode of an existing directory
+}
+
+// Remove deletes the file or (empty, unless recursive is set) directory at the given path.
+// It is not an error if the path does not exist.
+func (m *Local) Remove(fpath string, recursive bool) error {
+	fpath = util.PathSanitize(fpath)
+	if m.become != nil {
+		_, err := m.Run("rm", removeArgs(fpath, recursive))
+		return err
+	}
+
+	if recursive {
+		return os.RemoveAll(fpath)
+	}
+	if err := os.Remove(fpath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// Symlink creates a symlink at fpath pointing to target, replacing any existing file or symlink.
+func (m *Local) Symlink(target, fpath string) error {
+	fpath = util.PathSanitize(fpath)
+	if m.become != nil {
+		_, err := m.Run("sh", []string{"-c", symlinkScript(target, fpath)})
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			if linkErr := symlinkExitError(target, fpath, exitErr.ExitCode()); linkErr != nil {
+				return linkErr
+			}
+		}
+		return err
+	}
+
+	if fi, err := os.Lstat(fpath); err == nil && !fi.IsDir() {
+		if err = os.Remove(fpath); err != nil {
+			return err
+		}
+	}
+	return os.Symlink(target, fpath)
+}
+
+// Chown sets the owner and/or group of the file at the given path. Either may be a name or numeric ID,
+// and is left unchanged if empty.
+func (m *Local) Chown(fpath, owner, group string) error {
+	fpath = util.PathSanitize(fpath)
+	if m.become != nil {
+		_, err := m.Run("chown", chownArgs(fpath, owner, group))
+		return err
+	}
+
+	uid, err := lookupUID(owner)
+	if err != nil {
+		return err
+	}
+	gid, err := lookupGID(group)
+	if err != nil {
+		return err
+	}
+	return os.Chown(fpath, uid, gid)
 }
@@ -1,6 +1,12 @@
 package machine
 
-import "machassert/config"
+import (
+	"machassert/config"
+	"os"
+	"os/exec"
+
+	"golang.org/x/crypto/ssh"
+)
 
 type authPromptProvider interface {
 	AuthenticationPrompt(prompt string) (string, error)
@@ -14,11 +20,43 @@ type SSH struct {
 }
 
 // ConnectLocal returs a Local machine object
-func ConnectLocal(name string, machine *config.Machine) (*Local, error) {
+func ConnectLocal(name string, machine *config.Machine, auther authPromptProvider) (*Local, error) {
 	if machine.Kind != config.KindLocal {
 		panic("machine kind must be local")
 	}
+	b, err := newBecome(machine.Become, auther)
+	if err != nil {
+		return nil, err
+	}
 	return &Local{
 		MachineName: name,
+		become:      b,
 	}, nil
 }
+
+// unixMode returns the permission bits of mode (including setuid, setgid & sticky) as used by chmod(2).
+func unixMode(mode os.FileMode) uint32 {
+	out := uint32(mode.Perm())
+	if mode&os.ModeSetuid != 0 {
+		out |= 04000
+	}
+	if mode&os.ModeSetgid != 0 {
+		out |= 02000
+	}
+	if mode&os.ModeSticky != 0 {
+		out |= 01000
+	}
+	return out
+}
+
+// ExitStatus returns the exit status of the command which produced err, if err was caused
+// by a command (run with Run()) exiting with a non-zero status.
+func ExitStatus(err error) (int, bool) {
+	switch e := err.(type) {
+	case *exec.ExitError:
+		return e.ExitCode(), true
+	case *ssh.ExitError:
+		return e.ExitStatus(), true
+	}
+	return 0, false
+}
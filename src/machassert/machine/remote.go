@@ -3,35 +3,75 @@ package machine
 import (
 	"bytes"
 	"encoding/hex"
+	"errors"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"machassert/config"
 	"machassert/util"
+	"net"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/pkg/sftp"
 	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
 )
 
 // Remote is a target connected to via SSH.
 type Remote struct {
-	MachineName string
-	Address     string
-	authInfo    []config.MachineAuth
-	conn        *ssh.Client
+	MachineName  string
+	Address      string
+	authInfo     []config.MachineAuth
+	conn         *ssh.Client
+	hops         []*ssh.Client //jump host connections, in the order they were established
+	agentConn    net.Conn
+	forwardAgent bool
+	become       *become
+	sftp         *sftp.Client //nil if the server does not support SFTP, or become is set
 }
 
-// ConnectRemote opens an SSH connection to a remote target.
-func ConnectRemote(name string, m *config.Machine, auther authPromptProvider) (*Remote, error) {
-	c := &ssh.ClientConfig{User: m.Username, HostKeyCallback: ssh.InsecureIgnoreHostKey()}
-	for _, authItem := range m.Auth {
+// dialAgent connects to the ssh-agent listening on SSH_AUTH_SOCK.
+func dialAgent() (net.Conn, error) {
+	sock := os.Getenv("SSH_AUTH_SOCK")
+	if sock == "" {
+		return nil, errors.New("SSH_AUTH_SOCK is not set, is ssh-agent running?")
+	}
+	return net.Dial("unix", sock)
+}
+
+// sshConnector holds state shared between the hops of a connection.
+type sshConnector struct {
+	auther    authPromptProvider
+	opts      ConnectOptions
+	agentConn net.Conn
+}
+
+// sshAddress returns the host:port address of destination, defaulting to port 22.
+func sshAddress(destination string) string {
+	if !strings.Contains(destination, ":") {
+		return destination + ":22"
+	}
+	return destination
+}
+
+func (sc *sshConnector) clientConfig(destination, username string, auths []config.MachineAuth, hostKey, knownHosts string) (*ssh.ClientConfig, error) {
+	c := &ssh.ClientConfig{User: username, HostKeyCallback: hostKeyCallback(hostKey, knownHosts, sc.opts)}
+	var err error
+	if c.HostKeyAlgorithms, err = hostKeyAlgorithms(sshAddress(destination), hostKey, knownHosts, sc.opts); err != nil {
+		return nil, err
+	}
+	for _, authItem := range auths {
 		switch authItem.Kind {
 		case config.AuthKindPassword:
 			c.Auth = append(c.Auth, ssh.Password(authItem.Password))
 		case config.AuthKindPrompt:
-			c.Auth = append(c.Auth, ssh.KeyboardInteractive(auther.KeyboardInteractiveAuth))
+			c.Auth = append(c.Auth, ssh.KeyboardInteractive(sc.auther.KeyboardInteractiveAuth))
+			prompt := "Password for " + username + "@" + destination + ": "
 			c.Auth = append(c.Auth, ssh.PasswordCallback(func() (string, error) {
-				return auther.AuthenticationPrompt("Password: ")
+				return sc.auther.AuthenticationPrompt(prompt)
 			}))
 		case config.AuthKindLocalKey:
 			authItem.Key = "~/.ssh/id_rsa"
@@ -46,87 +86,218 @@ func ConnectRemote(name string, m *config.Machine, auther authPromptProvider) (*
 				return nil, err
 			}
 			c.Auth = append(c.Auth, ssh.PublicKeys(signer))
+		case config.AuthKindAgent:
+			if sc.agentConn == nil {
+				var err error
+				if sc.agentConn, err = dialAgent(); err != nil {
+					return nil, err
+				}
+			}
+			c.Auth = append(c.Auth, ssh.PublicKeysCallback(agent.NewClient(sc.agentConn).Signers))
 		}
 	}
+	return c, nil
+}
 
-	address := m.Destination
-	if !strings.Contains(address, ":") {
-		address += ":22"
+// dial connects to destination, tunneling through via if it is not nil.
+func (sc *sshConnector) dial(via *ssh.Client, destination string, c *ssh.ClientConfig) (*ssh.Client, error) {
+	address := sshAddress(destination)
+	if via == nil {
+		return ssh.Dial("tcp", address, c)
 	}
 
-	//TODO: Add ability to verify host key
-	client, err := ssh.Dial("tcp", address, c)
+	conn, err := via.Dial("tcp", address)
 	if err != nil {
 		return nil, err
 	}
-
-	return &Remote{
-		MachineName: name,
-		Address:     m.Destination,
-		authInfo:    m.Auth,
-		conn:        client,
-	}, nil
+	clientConn, chans, reqs, err := ssh.NewClientConn(conn, address, c)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return ssh.NewClient(clientConn, chans, reqs), nil
 }
 
-type readFileRemoteReadCloser struct {
-	buff    bytes.Buffer
-	session *ssh.Session
-}
+// ConnectRemote opens an SSH connection to a remote target, through any jump hosts listed in m.Via.
+func ConnectRemote(name string, m *config.Machine, auther authPromptProvider, opts ConnectOptions) (*Remote, error) {
+	sc := &sshConnector{auther: auther, opts: opts}
+	r := &Remote{
+		MachineName:  name,
+		Address:      m.Destination,
+		authInfo:     m.Auth,
+		forwardAgent: m.ForwardAgent,
+	}
+
+	var via *ssh.Client
+	for _, hop := range m.Via {
+		c, err := sc.clientConfig(hop.Destination, hop.Username, hop.Auth, hop.HostKey, hop.KnownHosts)
+		if err == nil {
+			via, err = sc.dial(via, hop.Destination, c)
+		}
+		if err != nil {
+			r.agentConn = sc.agentConn
+			r.Close()
+			return nil, fmt.Errorf("connecting to jump host %s: %v", hop.Destination, err)
+		}
+		r.hops = append(r.hops, via)
+	}
+
+	c, err := sc.clientConfig(m.Destination, m.Username, m.Auth, m.HostKey, m.KnownHosts)
+	if err == nil {
+		r.conn, err = sc.dial(via, m.Destination, c)
+	}
+	r.agentConn = sc.agentConn
+	if err != nil {
+		r.Close()
+		return nil, err
+	}
+
+	if r.become, err = newBecome(m.Become, auther); err != nil {
+		r.Close()
+		return nil, err
+	}
 
-func (r *readFileRemoteReadCloser) Read(p []byte) (int, error) {
-	return r.buff.Read(p)
+	// SFTP runs as the login user, so cannot be used if commands need to be wrapped.
+	if r.become == nil {
+		r.sftp, _ = newSFTPClient(r.conn)
+	}
+
+	if m.ForwardAgent {
+		sock := os.Getenv("SSH_AUTH_SOCK")
+		if sock == "" {
+			err = errors.New("cannot forward agent: SSH_AUTH_SOCK is not set")
+		} else {
+			err = agent.ForwardToRemote(r.conn, sock)
+		}
+		if err != nil {
+			r.Close()
+			return nil, err
+		}
+	}
+	return r, nil
 }
-func (r *readFileRemoteReadCloser) Close() error {
-	return r.session.Close()
+
+func closeIfSet(c io.Closer) {
+	if c != nil {
+		c.Close()
+	}
 }
 
-// ReadFile returns a reader to a file on a local machine.
-func (r *Remote) ReadFile(fpath string) (io.ReadCloser, error) {
+// newSession opens a new session on the connection, requesting agent forwarding if configured.
+func (r *Remote) newSession() (*ssh.Session, error) {
 	s, err := r.conn.NewSession()
 	if err != nil {
 		return nil, err
 	}
+	if r.forwardAgent {
+		if err = agent.RequestAgentForwarding(s); err != nil {
+			s.Close()
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// command returns the command line which runs name as the become user (if any).
+func (r *Remote) command(name string, args []string) string {
+	return shellJoin(r.become.wrap(name, args))
+}
+
+// ReadFile returns a reader to a file on a remote machine.
+func (r *Remote) ReadFile(fpath string) (io.ReadCloser, error) {
+	if r.sftp != nil {
+		return sftpOpen(r.sftp, fpath, os.O_RDONLY)
+	}
 
-	err = s.Run("cat " + fpath)
+	out, err := r.Run("sh", []string{"-c", readFileScript(fpath)})
 	if err != nil {
 		if exitError, ok := err.(*ssh.ExitError); ok {
-			if exitError.ExitStatus() == 1 {
-				return nil, os.ErrNotExist
+			if pathErr := readFileExitError(fpath, exitError.ExitStatus()); pathErr != nil {
+				return nil, pathErr
 			}
 		}
 		return nil, err
 	}
-
-	out := &readFileRemoteReadCloser{session: s}
-	s.Stdout = &out.buff
-	return out, nil
+	return ioutil.NopCloser(bytes.NewReader(out)), nil
 }
 
 // Run executes the specified command, returning output.
 func (r *Remote) Run(name string, args []string) ([]byte, error) {
 	var out bytes.Buffer
-	s, err := r.conn.NewSession()
+	s, err := r.newSession()
 	if err != nil {
 		return nil, err
 	}
+	defer s.Close()
 	s.Stdout = &out
+	s.Stdin = bytes.NewReader(r.become.stdinPrefix())
 
-	for i := range args {
-		if strings.ContainsAny(args[i], " |\"'") {
-			args[i] = "\"" + strings.Replace(args[i], "\"", "\\\"", -1) + "\""
-		}
+	err = s.Run(r.command(name, args))
+	if err != nil {
+		return nil, err
 	}
+	return out.Bytes(), nil
+}
 
-	err = s.Run(name + " " + strings.Join(args, " "))
+// Exec runs the command, returning its output and exit status. An error is only returned if the
+// command could not be run to completion.
+func (r *Remote) Exec(c *Command) (*CommandResult, error) {
+	s, err := r.newSession()
 	if err != nil {
 		return nil, err
 	}
-	return out.Bytes(), nil
+	defer s.Close()
+
+	var stdout, stderr bytes.Buffer
+	s.Stdout = &stdout
+	s.Stderr = &stderr
+	s.Stdin = io.MultiReader(bytes.NewReader(r.become.stdinPrefix()), bytes.NewReader(c.Stdin))
+
+	argv := c.argv()
+	if err = s.Start(r.command(argv[0], argv[1:])); err != nil {
+		return nil, err
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- s.Wait()
+	}()
+
+	var timeout <-chan time.Time
+	if c.Timeout > 0 {
+		timer := time.NewTimer(c.Timeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	select {
+	case err = <-done:
+	case <-timeout:
+		s.Signal(ssh.SIGKILL)
+		s.Close()
+		<-done
+		return &CommandResult{Stdout: stdout.Bytes(), Stderr: stderr.Bytes(), ExitStatus: -1}, timeoutError(c)
+	}
+
+	result := &CommandResult{Stdout: stdout.Bytes(), Stderr: stderr.Bytes()}
+	if status, isExit := ExitStatus(err); isExit {
+		result.ExitStatus = status
+		return result, nil
+	}
+	return result, err
 }
 
-// Hash returns the MD5 hash of the file at the given path.
-func (r *Remote) Hash(fpath string) ([]byte, error) {
-	o, err := r.Run("md5sum", []string{fpath})
+// Hash returns the hash of the file at the given path, using the given algorithm (md5 if empty).
+// If the machine has no command for the algorithm, the file is read and hashed locally.
+func (r *Remote) Hash(fpath, algorithm string) ([]byte, error) {
+	if _, err := NewHash(algorithm); err != nil {
+		return nil, err
+	}
+
+	o, err := r.Run("sh", sumArgs(algorithm, fpath))
+	if isCommandNotFound(err) {
+		return streamHash(r.ReadFile, fpath, algorithm)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -153,10 +324,18 @@ func (r *Remote) Name() string {
 
 // Close releases the resources associated with the machine.
 func (r *Remote) Close() error {
-	if r.conn == nil {
-		return nil
+	if r.sftp != nil {
+		r.sftp.Close()
+	}
+	var err error
+	if r.conn != nil {
+		err = r.conn.Close()
+	}
+	for i := len(r.hops) - 1; i >= 0; i-- {
+		r.hops[i].Close()
 	}
-	return r.conn.Close()
+	closeIfSet(r.agentConn)
+	return err
 }
 
 type sshWriter struct {
@@ -166,7 +345,9 @@ type sshWriter struct {
 
 func (w *sshWriter) Close() error {
 	w.w.Close()
-	return w.s.Close()
+	err := w.s.Wait()
+	w.s.Close()
+	return err
 }
 
 func (w *sshWriter) Write(in []byte) (int, error) {
@@ -174,8 +355,13 @@ func (w *sshWriter) Write(in []byte) (int, error) {
 }
 
 // WriteFile returns a writer which can be used to write content to the remote file.
+// Existing files keep their permissions and ownership.
 func (r *Remote) WriteFile(fpath string) (io.WriteCloser, error) {
-	s, err := r.conn.NewSession()
+	if r.sftp != nil {
+		return sftpOpen(r.sftp, fpath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC)
+	}
+
+	s, err := r.newSession()
 	if err != nil {
 		return nil, err
 	}
@@ -186,11 +372,122 @@ func (r *Remote) WriteFile(fpath string) (io.WriteCloser, error) {
 		return nil, err
 	}
 
-	args := []string{"cat - > " + strings.Replace(fpath, "\"", "\\\"", -1)}
-	err = s.Start(strings.Join(args, " "))
+	err = s.Start(r.command("sh", []string{"-c", "cat - > " + shellQuote(fpath)}))
 	if err != nil {
 		s.Close()
 		return nil, err
 	}
+	if _, err = pipe.Write(r.become.stdinPrefix()); err != nil {
+		s.Close()
+		return nil, err
+	}
 	return &sshWriter{s, pipe}, nil
 }
+
+// Chmod sets the permission bits of the file at the given path.
+func (r *Remote) Chmod(fpath string, mode os.FileMode) error {
+	if r.sftp != nil {
+		return sftpChmod(r.sftp, fpath, mode)
+	}
+	_, err := r.Run("chmod", []string{strconv.FormatUint(uint64(unixMode(mode)), 8), fpath})
+	return err
+}
+
+// Stat returns information about the file at the given path. Symlinks are not followed.
+func (r *Remote) Stat(fpath string) (*FileInfo, error) {
+	if r.sftp != nil {
+		return r.statSFTP(fpath)
+	}
+	out, err := r.Run("sh", []string{"-c", statScript(fpath)})
+	if status, isExit := ExitStatus(err); isExit && status == notExistExitCode {
+		return nil, &os.PathError{Op: "stat", Path: fpath, Err: os.ErrNotExist}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return parseStatOutput(out)
+}
+
+// Mkdir creates a directory with the given permissions. If parents is set, missing parents are created
+// and an existing directory is not an error, but has its permissions set.
+func (r *Remote) Mkdir(fpath string, mode os.FileMode, parents bool) error {
+	_, err := r.Run("sh", []string{"-c", mkdirScript(fpath, mode, parents)})
+	return err
+}
+
+// Remove deletes the file or (empty, unless recursive is set) directory at the given path.
+// It is not an error if the path does not exist.
+func (r *Remote) Remove(fpath string, recursive bool) error {
+	_, err := r.Run("rm", removeArgs(fpath, recursive))
+	return err
+}
+
+// Symlink creates a symlink at fpath pointing to target, replacing any existing file or symlink.
+func (r *Remote) Symlink(target, fpath string) error {
+	_, err := r.Run("sh", []string{"-c", symlinkScript(target, fpath)})
+	if exitError, ok := err.(*ssh.ExitError); ok {
+		if linkErr := symlinkExitError(target, fpath, exitError.ExitStatus()); linkErr != nil {
+			return linkErr
+		}
+	}
+	return err
+}
+
+// statSFTP returns information about the file at the given path using SFTP. SFTP only reports numeric
+// IDs, so user & group names are looked up with getent, and left empty if they cannot be.
+func (r *Remote) statSFTP(fpath string) (*FileInfo, error) {
+	info, err := sftpStat(r.sftp, fpath)
+	if err != nil {
+		return nil, err
+	}
+	if out, err := r.Run("sh", []string{"-c", idNamesScript(info.UID, info.GID)}); err == nil {
+		info.Owner, info.Group = parseIDNames(out)
+	}
+	return info, nil
+}
+
+// Chown sets the owner and/or group of the file at the given path. Either may be a name or numeric ID,
+// and is left unchanged if empty.
+func (r *Remote) Chown(fpath, owner, group string) error {
+	if r.sftp == nil {
+		_, err := r.Run("chown", chownArgs(fpath, owner, group))
+		return err
+	}
+
+	// SFTP sets the uid & gid together, so the current value is kept for whichever is not being changed.
+	info, err := sftpStat(r.sftp, fpath)
+	if err != nil {
+		return err
+	}
+	uid, gid := uint32(info.UID), uint32(info.GID)
+	if owner != "" {
+		if uid, err = r.lookupID("passwd", owner); err != nil {
+			return err
+		}
+	}
+	if group != "" {
+		if gid, err = r.lookupID("group", group); err != nil {
+			return err
+		}
+	}
+	return sftpChown(r.sftp, fpath, uid, gid)
+}
+
+// lookupID returns the numeric ID of a user (database = "passwd") or group (database = "group"),
+// which may already be numeric.
+func (r *Remote) lookupID(database, name string) (uint32, error) {
+	if id, err := strconv.ParseUint(name, 10, 32); err == nil {
+		return uint32(id), nil
+	}
+	out, err := r.Run("getent", []string{database, name})
+	if status, isExit := ExitStatus(err); isExit && status == getentNotFoundExitCode {
+		if database == "passwd" {
+			return 0, errors.New("unknown user: " + name)
+		}
+		return 0, errors.New("unknown group: " + name)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return parseGetentID(out)
+}
@@ -0,0 +1,81 @@
+package machine
+
+import (
+	"errors"
+	"os"
+	"strings"
+
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+)
+
+// sftpClientOptions configures SFTP clients to split reads and writes of a file into several requests
+// which are sent at once, so transfers are not bound by the round trip time.
+var sftpClientOptions = []sftp.ClientOption{sftp.UseConcurrentReads(true), sftp.UseConcurrentWrites(true)}
+
+// newSFTPClient starts the SFTP subsystem on the connection.
+func newSFTPClient(conn *ssh.Client) (*sftp.Client, error) {
+	return sftp.NewClient(conn, sftpClientOptions...)
+}
+
+// sftpPath converts a path into a form understood by the server. SFTP servers do not perform
+// tilde expansion, but resolve relative paths from the home directory.
+func sftpPath(fpath string) string {
+	if fpath == "~" {
+		return "."
+	}
+	if strings.HasPrefix(fpath, "~/") {
+		return fpath[2:]
+	}
+	return fpath
+}
+
+// sftpPathError adds the operation & path to the not exist and permission errors returned by the client,
+// which are returned without them.
+func sftpPathError(op, fpath string, err error) error {
+	if err == os.ErrNotExist || err == os.ErrPermission {
+		return &os.PathError{Op: op, Path: fpath, Err: err}
+	}
+	return err
+}
+
+// sftpOpen opens the file at the given path with the given os.OpenFile flags.
+func sftpOpen(c *sftp.Client, fpath string, flags int) (*sftp.File, error) {
+	f, err := c.OpenFile(sftpPath(fpath), flags)
+	return f, sftpPathError("open", fpath, err)
+}
+
+// sftpStat returns information about the file at the given path, without following symlinks. SFTP
+// only reports numeric IDs, so Owner & Group are not set.
+func sftpStat(c *sftp.Client, fpath string) (*FileInfo, error) {
+	fi, err := c.Lstat(sftpPath(fpath))
+	if err != nil {
+		return nil, sftpPathError("lstat", fpath, err)
+	}
+	stat, ok := fi.Sys().(*sftp.FileStat)
+	if !ok {
+		return nil, errors.New("sftp: no attributes returned for " + fpath)
+	}
+	info := &FileInfo{
+		Mode: fileModeFromUnix(stat.Mode),
+		Size: int64(stat.Size),
+		UID:  int(stat.UID),
+		GID:  int(stat.GID),
+	}
+	if info.Mode&os.ModeSymlink != 0 {
+		if info.LinkTarget, err = c.ReadLink(sftpPath(fpath)); err != nil {
+			return nil, sftpPathError("readlink", fpath, err)
+		}
+	}
+	return info, nil
+}
+
+// sftpChmod sets the permission bits of the file at the given path.
+func sftpChmod(c *sftp.Client, fpath string, mode os.FileMode) error {
+	return sftpPathError("chmod", fpath, c.Chmod(sftpPath(fpath), mode))
+}
+
+// sftpChown sets the numeric owner and group of the file at the given path.
+func sftpChown(c *sftp.Client, fpath string, uid, gid uint32) error {
+	return sftpPathError("chown", fpath, c.Chown(sftpPath(fpath), int(uid), int(gid)))
+}
@@ -0,0 +1,230 @@
+package machine
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/pkg/sftp"
+)
+
+// newTestSFTP returns a client connected to an SFTP server serving the local filesystem.
+func newTestSFTP(t *testing.T) (*sftp.Client, func()) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires POSIX file permissions")
+	}
+	clientConn, serverConn := net.Pipe()
+	server, err := sftp.NewServer(serverConn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	go server.Serve()
+
+	c, err := sftp.NewClientPipe(clientConn, clientConn, sftpClientOptions...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c, func() {
+		c.Close()
+		server.Close()
+	}
+}
+
+func tempDir(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "machine")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir, func() { os.RemoveAll(dir) }
+}
+
+func TestSFTPWriteRead(t *testing.T) {
+	c, closeSFTP := newTestSFTP(t)
+	defer closeSFTP()
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+
+	fpath := filepath.Join(dir, "a file")
+	data := bytes.Repeat([]byte("0123456789"), 100000) // spans many requests
+
+	for _, existing := range []bool{false, true} {
+		w, err := sftpOpen(c, fpath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = io.Copy(w, bytes.NewReader(data)); err != nil {
+			t.Fatal(err)
+		}
+		if err = w.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		if got, err := ioutil.ReadFile(fpath); err != nil || !bytes.Equal(got, data) {
+			t.Errorf("existing=%v: wrote %d bytes, want %d (%v)", existing, len(got), len(data), err)
+		}
+
+		r, err := sftpOpen(c, fpath, os.O_RDONLY)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got bytes.Buffer
+		if _, err = io.Copy(&got, r); err != nil {
+			t.Fatal(err)
+		}
+		r.Close()
+		if !bytes.Equal(got.Bytes(), data) {
+			t.Errorf("existing=%v: read %d bytes, want %d", existing, got.Len(), len(data))
+		}
+		data = data[:len(data)/3] // the second write must truncate the file
+	}
+}
+
+func TestSFTPPartialReadsAndWrites(t *testing.T) {
+	c, closeSFTP := newTestSFTP(t)
+	defer closeSFTP()
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+
+	fpath := filepath.Join(dir, "f")
+	w, err := sftpOpen(c, fpath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want []byte
+	for _, size := range []int{1, 0, 7, 70000, 3} {
+		chunk := bytes.Repeat([]byte{byte('a' + size%26)}, size)
+		if n, err := w.Write(chunk); err != nil || n != size {
+			t.Fatalf("Write(%d bytes) = %d, %v", size, n, err)
+		}
+		want = append(want, chunk...)
+	}
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := sftpOpen(c, fpath, os.O_RDONLY)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	var got []byte
+	buf := make([]byte, 5000)
+	for {
+		n, err := r.Read(buf)
+		got = append(got, buf[:n]...)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("read %d bytes, want %d", len(got), len(want))
+	}
+}
+
+func TestSFTPErrors(t *testing.T) {
+	c, closeSFTP := newTestSFTP(t)
+	defer closeSFTP()
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+
+	missing := filepath.Join(dir, "missing")
+	if _, err := sftpOpen(c, missing, os.O_RDONLY); !os.IsNotExist(err) || !strings.Contains(err.Error(), missing) {
+		t.Errorf("open missing file: expected not exist error for the path, got %v", err)
+	}
+	if _, err := sftpStat(c, missing); !os.IsNotExist(err) {
+		t.Errorf("stat missing file: expected not exist error, got %v", err)
+	}
+	if err := sftpChmod(c, missing, 0644); !os.IsNotExist(err) {
+		t.Errorf("chmod missing file: expected not exist error, got %v", err)
+	}
+	if _, err := sftpOpen(c, filepath.Join(missing, "f"), os.O_WRONLY|os.O_CREATE); err == nil {
+		t.Error("expected error creating a file in a missing directory")
+	}
+	// Opening a directory for writing fails with a status which has no os equivalent.
+	if _, err := sftpOpen(c, dir, os.O_WRONLY); err == nil || os.IsNotExist(err) {
+		t.Errorf("open directory for writing: got %v", err)
+	}
+}
+
+func TestSFTPPathError(t *testing.T) {
+	other := errors.New("sftp: failure")
+	tcs := []struct {
+		err  error
+		want error
+	}{
+		{nil, nil},
+		{os.ErrNotExist, &os.PathError{Op: "open", Path: "/f", Err: os.ErrNotExist}},
+		{os.ErrPermission, &os.PathError{Op: "open", Path: "/f", Err: os.ErrPermission}},
+		{other, other},
+		{&sftp.StatusError{Code: 4}, &sftp.StatusError{Code: 4}},
+	}
+	for _, tc := range tcs {
+		if got := sftpPathError("open", "/f", tc.err); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("sftpPathError(%v) = %v, want %v", tc.err, got, tc.want)
+		}
+	}
+}
+
+func TestSFTPStatChmodChown(t *testing.T) {
+	c, closeSFTP := newTestSFTP(t)
+	defer closeSFTP()
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+
+	fpath := filepath.Join(dir, "conf")
+	if err := ioutil.WriteFile(fpath, []byte("hello"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	link := filepath.Join(dir, "link")
+	if err := os.Symlink("conf", link); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := sftpChmod(c, fpath, 0640); err != nil {
+		t.Fatal(err)
+	}
+	if err := sftpChown(c, fpath, uint32(os.Getuid()), uint32(os.Getgid())); err != nil {
+		t.Fatal(err)
+	}
+	info, err := sftpStat(c, fpath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &FileInfo{Mode: 0640, Size: 5, UID: os.Getuid(), GID: os.Getgid()}
+	if !reflect.DeepEqual(info, want) {
+		t.Errorf("got %+v, want %+v", info, want)
+	}
+
+	info, err = sftpStat(c, link)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode&os.ModeSymlink == 0 || info.LinkTarget != "conf" {
+		t.Errorf("expected symlink to conf, got %+v", info)
+	}
+}
+
+func TestSFTPPath(t *testing.T) {
+	tcs := map[string]string{
+		"~":        ".",
+		"~/a/b":    "a/b",
+		"/etc/x":   "/etc/x",
+		"relative": "relative",
+	}
+	for in, want := range tcs {
+		if got := sftpPath(in); got != want {
+			t.Errorf("sftpPath(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
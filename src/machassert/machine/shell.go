@@ -0,0 +1,53 @@
+package machine
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Exit statuses of readFileScript when the file cannot be read.
+const (
+	notExistExitCode   = 44
+	permissionExitCode = 45
+)
+
+// readFileScript returns a shell script which writes the contents of fpath to stdout,
+// exiting with notExistExitCode or permissionExitCode if the file does not exist or cannot be read.
+func readFileScript(fpath string) string {
+	q := shellQuote(fpath)
+	return "[ -e " + q + " ] || exit " + strconv.Itoa(notExistExitCode) +
+		"; [ -r " + q + " ] || exit " + strconv.Itoa(permissionExitCode) + "; cat " + q
+}
+
+// readFileExitError converts the exit status of readFileScript into the equivalent os error.
+func readFileExitError(fpath string, exitStatus int) error {
+	switch exitStatus {
+	case notExistExitCode:
+		return &os.PathError{Op: "open", Path: fpath, Err: os.ErrNotExist}
+	case permissionExitCode:
+		return &os.PathError{Op: "open", Path: fpath, Err: os.ErrPermission}
+	}
+	return nil
+}
+
+// shellQuote quotes s so it is interpreted literally by a POSIX shell. A leading ~/ is left
+// unquoted so the shell still expands it to the home directory.
+func shellQuote(s string) string {
+	if strings.HasPrefix(s, "~/") {
+		return "~/" + shellQuote(s[2:])
+	}
+	if s != "" && strings.Trim(s, "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789-_./=:,+@") == "" {
+		return s
+	}
+	return "'" + strings.Replace(s, "'", "'\"'\"'", -1) + "'"
+}
+
+// shellJoin quotes and joins the arguments into a single command line.
+func shellJoin(argv []string) string {
+	out := make([]string, len(argv))
+	for i := range argv {
+		out[i] = shellQuote(argv[i])
+	}
+	return strings.Join(out, " ")
+}
@@ -0,0 +1,143 @@
+package machine
+
+import (
+	"fmt"
+	"os"
+	"os/user"
+	"strconv"
+	"strings"
+)
+
+// FileInfo describes a file on a machine.
+type FileInfo struct {
+	Mode       os.FileMode // permission and type bits
+	Size       int64
+	UID, GID   int
+	Owner      string // user name, empty if unknown
+	Group      string // group name, empty if unknown
+	LinkTarget string // only set for symlinks
+}
+
+// statScript returns a shell script which prints the raw mode (hex), size, uid and gid of fpath (without following
+// symlinks) on one line, its owner and group names on the next two, and the link target on the next if it is a
+// symlink. GNU (and BusyBox) stat is tried before BSD stat, whose format syntax differs. Names are printed on their
+// own lines as they may contain spaces. It exits with notExistExitCode if the file does not exist.
+func statScript(fpath string) string {
+	q := shellQuote(fpath)
+	return "[ -e " + q + " ] || [ -L " + q + " ] || exit " + strconv.Itoa(notExistExitCode) +
+		"; { stat -c '%f %s %u %g\n%U\n%G' -- " + q + " 2>/dev/null || stat -f '%Xp %z %u %g%n%Su%n%Sg' -- " + q + "; }" +
+		" && { [ ! -L " + q + " ] || readlink -- " + q + "; }"
+}
+
+// parseStatOutput parses the output of statScript.
+func parseStatOutput(out []byte) (*FileInfo, error) {
+	lines := strings.SplitN(strings.TrimRight(string(out), "\n"), "\n", 4)
+	fields := strings.Fields(lines[0])
+	if len(fields) != 4 || len(lines) < 3 {
+		return nil, fmt.Errorf("unexpected stat output: %q", out)
+	}
+
+	rawMode, err := strconv.ParseUint(fields[0], 16, 32)
+	if err != nil {
+		return nil, err
+	}
+	info := &FileInfo{Mode: fileModeFromUnix(uint32(rawMode))}
+	if info.Size, err = strconv.ParseInt(fields[1], 10, 64); err != nil {
+		return nil, err
+	}
+	if info.UID, err = strconv.Atoi(fields[2]); err != nil {
+		return nil, err
+	}
+	if info.GID, err = strconv.Atoi(fields[3]); err != nil {
+		return nil, err
+	}
+	// GNU stat prints UNKNOWN for IDs without a name, and BSD stat the ID itself
+	if lines[1] != "UNKNOWN" && lines[1] != fields[2] {
+		info.Owner = lines[1]
+	}
+	if lines[2] != "UNKNOWN" && lines[2] != fields[3] {
+		info.Group = lines[2]
+	}
+	if len(lines) > 3 {
+		info.LinkTarget = lines[3]
+	}
+	return info, nil
+}
+
+// idNamesScript returns a shell script which prints the name of the user with the given uid, and the
+// name of the group with the given gid, each on its own line. Names which cannot be found are empty.
+func idNamesScript(uid, gid int) string {
+	return `printf '%s\n%s\n' "$(getent passwd ` + strconv.Itoa(uid) + ` | cut -d: -f1)" "$(getent group ` +
+		strconv.Itoa(gid) + ` | cut -d: -f1)"`
+}
+
+// parseIDNames parses the output of idNamesScript.
+func parseIDNames(out []byte) (owner, group string) {
+	lines := strings.SplitN(strings.TrimRight(string(out), "\n"), "\n", 2)
+	owner = lines[0]
+	if len(lines) > 1 {
+		group = lines[1]
+	}
+	return owner, group
+}
+
+// Unix file type bits
+const (
+	unixTypeMask    = 0170000
+	unixTypeSocket  = 0140000
+	unixTypeSymlink = 0120000
+	unixTypeRegular = 0100000
+	unixTypeBlock   = 0060000
+	unixTypeDir     = 0040000
+	unixTypeChar    = 0020000
+	unixTypeFifo    = 0010000
+)
+
+// fileModeFromUnix converts a raw st_mode into an os.FileMode.
+func fileModeFromUnix(m uint32) os.FileMode {
+	mode := os.FileMode(m & 0777)
+	switch m & unixTypeMask {
+	case unixTypeSocket:
+		mode |= os.ModeSocket
+	case unixTypeSymlink:
+		mode |= os.ModeSymlink
+	case unixTypeBlock:
+		mode |= os.ModeDevice
+	case unixTypeDir:
+		mode |= os.ModeDir
+	case unixTypeChar:
+		mode |= os.ModeDevice | os.ModeCharDevice
+	case unixTypeFifo:
+		mode |= os.ModeNamedPipe
+	}
+	if m&04000 != 0 {
+		mode |= os.ModeSetuid
+	}
+	if m&02000 != 0 {
+		mode |= os.ModeSetgid
+	}
+	if m&01000 != 0 {
+		mode |= os.ModeSticky
+	}
+	return mode
+}
+
+// localFileInfo builds a FileInfo from a local os.FileInfo, resolving user & group names.
+func localFileInfo(fpath string, fi os.FileInfo) (*FileInfo, error) {
+	info := &FileInfo{Mode: fi.Mode(), Size: fi.Size()}
+	info.UID, info.GID = fileOwner(fi)
+	if u, err := user.LookupId(strconv.Itoa(info.UID)); err == nil {
+		info.Owner = u.Username
+	}
+	if g, err := user.LookupGroupId(strconv.Itoa(info.GID)); err == nil {
+		info.Group = g.Name
+	}
+	if fi.Mode()&os.ModeSymlink != 0 {
+		target, err := os.Readlink(fpath)
+		if err != nil {
+			return nil, err
+		}
+		info.LinkTarget = target
+	}
+	return info, nil
+}
@@ -0,0 +1,112 @@
+package machine
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"testing"
+)
+
+func TestParseStatOutput(t *testing.T) {
+	tcs := []struct {
+		name string
+		out  string
+		want *FileInfo
+	}{
+		{
+			name: "file",
+			out:  "81a4 12 0 0\nroot\nroot\n",
+			want: &FileInfo{Mode: 0644, Size: 12, Owner: "root", Group: "root"},
+		},
+		{
+			name: "names with spaces",
+			out:  "41ed 4096 1000 1001\nJohn Smith\ndomain users\n",
+			want: &FileInfo{Mode: os.ModeDir | 0755, Size: 4096, UID: 1000, GID: 1001, Owner: "John Smith", Group: "domain users"},
+		},
+		{
+			name: "unknown names (GNU)",
+			out:  "8180 0 1234 5678\nUNKNOWN\nUNKNOWN\n",
+			want: &FileInfo{Mode: 0600, UID: 1234, GID: 5678},
+		},
+		{
+			name: "unknown names (BSD)",
+			out:  "8180 0 1234 5678\n1234\n5678\n",
+			want: &FileInfo{Mode: 0600, UID: 1234, GID: 5678},
+		},
+		{
+			name: "symlink",
+			out:  "a1ff 11 0 0\nroot\nwheel\n/etc/target file\n",
+			want: &FileInfo{Mode: os.ModeSymlink | 0777, Size: 11, Owner: "root", Group: "wheel", LinkTarget: "/etc/target file"},
+		},
+	}
+	for _, tc := range tcs {
+		got, err := parseStatOutput([]byte(tc.out))
+		if err != nil {
+			t.Errorf("%s: %v", tc.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%s: got %+v, want %+v", tc.name, got, tc.want)
+		}
+	}
+
+	for _, out := range []string{"", "81a4 12 0 0 root root\n", "81a4 12 0\nroot\nroot\n"} {
+		if _, err := parseStatOutput([]byte(out)); err == nil {
+			t.Errorf("expected error parsing %q", out)
+		}
+	}
+}
+
+func TestStatScript(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires a POSIX shell")
+	}
+	dir, err := ioutil.TempDir("", "stat")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	fpath := filepath.Join(dir, "a file")
+	if err = ioutil.WriteFile(fpath, []byte("hello"), 0640); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Chmod(fpath, 0640); err != nil {
+		t.Fatal(err)
+	}
+	link := filepath.Join(dir, "link")
+	if err = os.Symlink("a file", link); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, p := range []string{fpath, link} {
+		fi, err := os.Lstat(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := localFileInfo(p, fi)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		out, err := exec.Command("sh", "-c", statScript(p)).Output()
+		if err != nil {
+			t.Fatalf("%s: %v", p, err)
+		}
+		got, err := parseStatOutput(out)
+		if err != nil {
+			t.Fatalf("%s: %v", p, err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: got %+v, want %+v", p, got, want)
+		}
+	}
+
+	err = exec.Command("sh", "-c", statScript(filepath.Join(dir, "missing"))).Run()
+	if status, isExit := ExitStatus(err); !isExit || status != notExistExitCode {
+		t.Errorf("missing file: got %v, want exit status %d", err, notExistExitCode)
+	}
+}
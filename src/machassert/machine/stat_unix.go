@@ -0,0 +1,17 @@
+//go:build !windows
+// +build !windows
+
+package machine
+
+import (
+	"os"
+	"syscall"
+)
+
+// fileOwner returns the uid & gid of the file.
+func fileOwner(fi os.FileInfo) (int, int) {
+	if st, ok := fi.Sys().(*syscall.Stat_t); ok {
+		return int(st.Uid), int(st.Gid)
+	}
+	return -1, -1
+}
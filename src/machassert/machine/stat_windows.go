@@ -0,0 +1,8 @@
+package machine
+
+import "os"
+
+// fileOwner returns the uid & gid of the file. Windows has no equivalent, so -1 is always returned.
+func fileOwner(fi os.FileInfo) (int, int) {
+	return -1, -1
+}
@@ -1,34 +1,98 @@
 package main
 
 import (
+	"encoding/json"
 	"errors"
 	"flag"
 	"fmt"
 	"machassert/config"
 	"machassert/engine"
+	"machassert/machine"
 	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
 
 	"github.com/davecgh/go-spew/spew"
+	"golang.org/x/term"
 )
 
 var (
-	targetsFilePathVar = flag.String("targets", "", "Path to targets file")
+	targetsFilePathVar = flag.String("targets", "", "Path to targets file, JSON inventory (ending in .json), or exec:<path> of an executable printing a JSON inventory")
+	tagsVar            = flag.String("tags", "", "Only run assertions with at least one of these comma-separated tags")
+	skipTagsVar        = flag.String("skip-tags", "", "Don't run assertions with any of these comma-separated tags")
+	onlyVar            = flag.String("only", "", "Only run these comma-separated assertions, given as <spec name>.<assertion name>")
+	limitVar           = flag.String("limit", "", "Only assert on the selected machines: comma-separated machine name globs, group names & tag:<tag>, prefixed with & to intersect or ! to exclude")
+	parallelismVar     = flag.Int("parallel", 0, "Maximum number of machines to assert on concurrently (overrides the targets file)")
+	assertionParVar    = flag.Int("assertion-parallel", 1, "Maximum number of assertions to run concurrently on each machine, once their depends_on hold")
+	continueVar        = flag.Bool("continue-on-failure", false, "Keep running assertions (and machines) after an assertion fails, reporting every failure at the end")
+	knownHostsVar      = flag.String("known-hosts", machine.DefaultKnownHostsPath, "Path to the known_hosts file used to verify SSH host keys")
+	trustOnFirstUseVar = flag.Bool("trust-on-first-use", false, "Add the host keys of unknown SSH hosts to the known_hosts file instead of refusing to connect")
+	outputVar          = flag.String("output", "console", "Output format: console, plain for a line per status change (the default if stdout is not a terminal), or json for one JSON object per line")
+	plainVar           = flag.Bool("plain", false, "Shorthand for --output plain")
+	noColorVar         = flag.Bool("no-color", false, "Disable colors, and use plain output instead of console output")
+	reportsVar         reportFlags
 	assertionsFiles    []string
 	modeVar            string
 )
 
+// reportFlag is a report requested with --report <format>=<path>.
+type reportFlag struct {
+	format, path string
+}
+
+// reportFlags implements flag.Value, so --report can be given multiple times.
+type reportFlags []reportFlag
+
+func (r *reportFlags) String() string {
+	var out []string
+	for _, f := range *r {
+		out = append(out, f.format+"="+f.path)
+	}
+	return strings.Join(out, ",")
+}
+
+func (r *reportFlags) Set(value string) error {
+	parts := strings.SplitN(value, "=", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return errors.New("must be in the form <format>=<path>")
+	}
+	for _, format := range engine.ReportFormats {
+		if parts[0] == format {
+			*r = append(*r, reportFlag{format: parts[0], path: parts[1]})
+			return nil
+		}
+	}
+	return errors.New("format must be one of " + strings.Join(engine.ReportFormats, "/"))
+}
+
 func processFlags() {
+	flag.Var(&reportsVar, "report", "Write a report of the run, as <format>=<path> where format is junit or tap (can be given multiple times)")
 	flag.Parse()
 	modeVar = flag.Arg(0)
 
 	if modeVar == "" {
 		fmt.Printf("USAGE: %s [--targets <target file>] <mode> <assertion files>\n", os.Args[0])
+		fmt.Printf("       %s [--targets <target file>] [--limit <machines>] list-targets\n", os.Args[0])
 		os.Exit(1)
 	}
 	assertionsFiles = flag.Args()[1:]
 
+	if *outputVar != "console" && *outputVar != "plain" && *outputVar != "json" {
+		fmt.Printf("Invalid output format: %q (must be console, plain or json)\n", *outputVar)
+		os.Exit(1)
+	}
+	// The console output redraws the screen, which only works on a terminal.
+	stdoutIsTerminal := term.IsTerminal(int(os.Stdout.Fd()))
+	if *outputVar == "console" && (*plainVar || *noColorVar || !stdoutIsTerminal) {
+		*outputVar = "plain"
+	}
+	if *noColorVar || os.Getenv("NO_COLOR") != "" || !stdoutIsTerminal {
+		engine.DisableColor()
+	}
+
 	if *targetsFilePathVar != "" { //If it is empty, we use the current machine we are on
-		if _, err := os.Stat(*targetsFilePathVar); err != nil && os.IsNotExist(err) {
+		if _, err := os.Stat(strings.TrimPrefix(*targetsFilePathVar, config.ExecInventoryPrefix)); err != nil && os.IsNotExist(err) {
 			fmt.Printf("Could not stat targets: %s\n", err.Error())
 			os.Exit(1)
 		}
@@ -47,7 +111,7 @@ func getAssertionsSpecs() ([]*config.AssertionSpec, error) {
 		}
 		out = append(out, assertions)
 	}
-	return out, nil
+	return out, config.CheckDependencies(out)
 }
 
 func getTargetSpec() (targets *config.MachineSpec) {
@@ -61,32 +125,195 @@ func getTargetSpec() (targets *config.MachineSpec) {
 			os.Exit(1)
 		}
 	}
+	if *limitVar != "" {
+		var err error
+		if targets, err = targets.Limit(*limitVar); err != nil {
+			fmt.Printf("Err: %s\n", err.Error())
+			os.Exit(1)
+		}
+	}
 	return targets
 }
 
+// listTargets prints the machines in the targets, with their groups and tags.
+func listTargets(targets *config.MachineSpec) {
+	names := make([]string, 0, len(targets.Machine))
+	for name := range targets.Machine {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tKIND\tDESTINATION\tGROUPS\tTAGS")
+	for _, name := range names {
+		m := targets.Machine[name]
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", name, m.Kind, orDash(m.Destination),
+			orDash(strings.Join(targets.MachineGroups(name), ",")), orDash(strings.Join(m.Tags, ",")))
+	}
+	w.Flush()
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func printPlan(plan []*engine.PlannedAction) {
+	fmt.Println()
+	if len(plan) == 0 {
+		fmt.Println("Plan: no actions would be applied.")
+		return
+	}
+
+	fmt.Println("Plan:")
+	lastMachine := ""
+	for _, p := range plan {
+		if p.Machine != lastMachine {
+			fmt.Printf("  %s:\n", engine.Cyan(p.Machine))
+			lastMachine = p.Machine
+		}
+		fmt.Printf("    %s: %s\n", p.Assertion, engine.Yellow(p.String()))
+	}
+}
+
+// printPlanJSON writes each planned action as a JSON line, in the same format as the json output.
+func printPlanJSON(plan []*engine.PlannedAction) {
+	enc := json.NewEncoder(os.Stdout)
+	for _, p := range plan {
+		enc.Encode(map[string]string{
+			"event":       "planned_action",
+			"machine":     p.Machine,
+			"assertion":   p.Assertion,
+			"kind":        p.Action.Kind,
+			"description": p.String(),
+		})
+	}
+}
+
+// fatal prints the error and exits. With json output, errors are written to stderr so stdout
+// only contains JSON lines.
+func fatal(err error) {
+	if *outputVar == "json" {
+		fmt.Fprintln(os.Stderr, "Err:", err.Error())
+		os.Exit(1)
+	}
+	if err == engine.ErrAssertionsFailed {
+		fmt.Println(engine.Red("Error") + ": Assertions failed")
+	} else {
+		fmt.Println("Err:", err.Error())
+	}
+	os.Exit(1)
+}
+
+// writeReports writes the reports requested with --report.
+func writeReports(e *engine.Executor) error {
+	for _, r := range reportsVar {
+		f, err := os.Create(r.path)
+		if err != nil {
+			return err
+		}
+		err = engine.WriteReport(f, r.format, e.Results(), e.Failures())
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return errors.New("writing " + r.format + " report: " + err.Error())
+		}
+	}
+	return nil
+}
+
+// splitList splits a comma-separated flag value, ignoring empty entries.
+func splitList(s string) []string {
+	var out []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+// assertionFilter returns the assertion filter described by the --tags, --skip-tags & --only flags.
+func assertionFilter(assertions []*config.AssertionSpec) (engine.AssertionFilter, error) {
+	f := engine.AssertionFilter{
+		Tags:     splitList(*tagsVar),
+		SkipTags: splitList(*skipTagsVar),
+		Only:     splitList(*onlyVar),
+	}
+	return f, f.Check(assertions)
+}
+
+// newExecutor returns an executor configured from the command line flags.
+func newExecutor(targets *config.MachineSpec, assertions []*config.AssertionSpec, filter engine.AssertionFilter) *engine.Executor {
+	e := engine.New(targets, assertions)
+	e.SetFilter(filter)
+	e.SetParallelism(*parallelismVar)
+	e.SetAssertionParallelism(*assertionParVar)
+	e.SetContinueOnFailure(*continueVar)
+	e.SetConnectOptions(machine.ConnectOptions{
+		KnownHostsPath:  *knownHostsVar,
+		TrustOnFirstUse: *trustOnFirstUseVar,
+	})
+	switch *outputVar {
+	case "json":
+		e.SetLogger(engine.NewJSONLogger(os.Stdout))
+	case "plain":
+		e.SetLogger(&engine.PlainLogger{})
+	}
+	return e
+}
+
 func main() {
 	processFlags()
 	targets := getTargetSpec()
+	if modeVar == "list-targets" {
+		listTargets(targets)
+		return
+	}
 	assertions, err := getAssertionsSpecs()
 	if err != nil {
-		fmt.Println("Err:", err.Error())
-		os.Exit(1)
+		fatal(err)
+	}
+	filter, err := assertionFilter(assertions)
+	if err != nil {
+		fatal(err)
 	}
+	consoleOutput := *outputVar == "console"
 
 	switch modeVar {
 	case "run":
 		fallthrough
 	case "assert":
-		fmt.Println("\n")
-		e := engine.New(targets, assertions)
+		if consoleOutput {
+			fmt.Print("\n\n")
+		}
+		e := newExecutor(targets, assertions, filter)
 		err = e.Run()
-		if err != nil && err == engine.ErrAssertionsFailed {
-			fmt.Println(engine.Red("Error") + ": Assertions failed")
-			os.Exit(1)
+		if reportErr := writeReports(e); reportErr != nil {
+			fatal(reportErr)
 		}
 		if err != nil {
-			fmt.Println("Err:", err.Error())
-			os.Exit(1)
+			fatal(err)
+		}
+
+	case "plan":
+		if consoleOutput {
+			fmt.Print("\n\n")
+		}
+		e := newExecutor(targets, assertions, filter)
+		e.SetDryRun(true)
+		err = e.Run()
+		// The actions planned before an error are still printed, as they would have been applied.
+		if *outputVar == "json" {
+			printPlanJSON(e.Plan())
+		} else {
+			printPlan(e.Plan())
+		}
+		if err != nil {
+			fatal(err)
 		}
 
 	case "print":